@@ -0,0 +1,54 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// addOnName returns the name of the installed add-on, falling back to its identifier when the
+// installation only links to the add-on
+func addOnName(addOn *cmv1.AddOnInstallation) string {
+	if name := addOn.Addon().Name(); name != "" {
+		return name
+	}
+	return addOn.Addon().ID()
+}
+
+// addOnState returns the state of the installed add-on. Installations that don't report a state
+// yet are still being installed.
+func addOnState(addOn *cmv1.AddOnInstallation) string {
+	if state := addOn.State(); state != "" {
+		return string(state)
+	}
+	return string(cmv1.AddOnInstallationStateInstalling)
+}
+
+// addOnsDescription lists the installed add-ons with their state and version
+func addOnsDescription(addOns []*cmv1.AddOnInstallation) string {
+	if len(addOns) == 0 {
+		return "Add-ons:                   None\n"
+	}
+	str := "Add-ons:\n"
+	for _, addOn := range addOns {
+		line := addOnState(addOn)
+		if version := addOn.AddonVersion().ID(); version != "" {
+			line += fmt.Sprintf(" (%s)", version)
+		}
+		str += fmt.Sprintf(" - %s: %s\n", addOnName(addOn), line)
+	}
+	return str
+}
+
+func formatAddOns(addOns []*cmv1.AddOnInstallation) []map[string]string {
+	list := []map[string]string{}
+	for _, addOn := range addOns {
+		list = append(list, map[string]string{
+			"id":      addOn.Addon().ID(),
+			"name":    addOnName(addOn),
+			"state":   addOnState(addOn),
+			"version": addOn.AddonVersion().ID(),
+		})
+	}
+	return list
+}
@@ -0,0 +1,30 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	cadmin "github.com/openshift/rosa/cmd/create/admin"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// hasClusterAdmin checks if any of the HTPasswd identity providers of the cluster has the
+// cluster-admin user. Clusters created with older versions of rosa don't name the provider
+// 'cluster-admin', so all of them are searched.
+func hasClusterAdmin(r *rosa.Runtime, cluster *cmv1.Cluster, idps []*cmv1.IdentityProvider) (bool, error) {
+	for _, idp := range idps {
+		if ocm.IdentityProviderType(idp) != ocm.HTPasswdIDPType {
+			continue
+		}
+		userList, err := r.OCMClient.GetHTPasswdUserList(cluster.ID(), idp.ID())
+		if err != nil {
+			return false, fmt.Errorf("failed to get the users of identity provider '%s': %v", idp.Name(), err)
+		}
+		if cadmin.HasClusterAdmin(userList) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,48 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+// autoscalerDescription shows the scale down settings of the cluster autoscaler, which decide
+// how aggressively the nodes that aren't needed are removed
+func autoscalerDescription(autoscaler *cmv1.ClusterAutoscaler) string {
+	if autoscaler == nil {
+		return ""
+	}
+	scaleDown := autoscaler.ScaleDown()
+	str := "Autoscaler:\n"
+	str += fmt.Sprintf(" - Scale Down:              %s\n", output.PrintBool(scaleDown.Enabled()))
+	if scaleDown.DelayAfterAdd() != "" {
+		str += fmt.Sprintf(" - Delay After Add:         %s\n", scaleDown.DelayAfterAdd())
+	}
+	if scaleDown.DelayAfterDelete() != "" {
+		str += fmt.Sprintf(" - Delay After Delete:      %s\n", scaleDown.DelayAfterDelete())
+	}
+	if scaleDown.DelayAfterFailure() != "" {
+		str += fmt.Sprintf(" - Delay After Failure:     %s\n", scaleDown.DelayAfterFailure())
+	}
+	if scaleDown.UnneededTime() != "" {
+		str += fmt.Sprintf(" - Unneeded Time:           %s\n", scaleDown.UnneededTime())
+	}
+	if scaleDown.UtilizationThreshold() != "" {
+		str += fmt.Sprintf(" - Utilization Threshold:   %s\n", scaleDown.UtilizationThreshold())
+	}
+	return str
+}
+
+func formatAutoscalerScaleDown(autoscaler *cmv1.ClusterAutoscaler) map[string]interface{} {
+	scaleDown := autoscaler.ScaleDown()
+	return map[string]interface{}{
+		"enabled":              scaleDown.Enabled(),
+		"delayAfterAdd":        scaleDown.DelayAfterAdd(),
+		"delayAfterDelete":     scaleDown.DelayAfterDelete(),
+		"delayAfterFailure":    scaleDown.DelayAfterFailure(),
+		"unneededTime":         scaleDown.UnneededTime(),
+		"utilizationThreshold": scaleDown.UtilizationThreshold(),
+	}
+}
@@ -0,0 +1,28 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// nodeTotal returns the number of nodes that the cluster runs. Hosted control planes don't have
+// control plane or infra nodes of their own.
+func nodeTotal(cluster *cmv1.Cluster) int {
+	return cluster.Nodes().Master() + cluster.Nodes().Infra() + cluster.Status().CurrentCompute()
+}
+
+// summaryBanner renders the line at the top of the description with the facts that are checked
+// most often, so that they don't need to be searched for in long descriptions. It's a label like
+// the others, so that the description can still be parsed as YAML.
+func summaryBanner(cluster *cmv1.Cluster) string {
+	return fmt.Sprintf("\n"+
+		"Summary:                    %s (%s), %s, %s, %s, %s nodes\n",
+		cluster.Name(),
+		cluster.ID(),
+		cluster.State(),
+		cluster.OpenshiftVersion(),
+		cluster.Region().ID(),
+		formatCount(nodeTotal(cluster)),
+	)
+}
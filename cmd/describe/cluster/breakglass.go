@@ -0,0 +1,56 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// activeBreakGlassCredentials returns the break glass credentials that were issued and haven't
+// expired or been revoked yet
+func activeBreakGlassCredentials(credentials []*cmv1.BreakGlassCredential,
+	now time.Time) []*cmv1.BreakGlassCredential {
+	active := []*cmv1.BreakGlassCredential{}
+	for _, credential := range credentials {
+		if credential.Status() == cmv1.BreakGlassCredentialStatusIssued &&
+			credential.ExpirationTimestamp().After(now) {
+			active = append(active, credential)
+		}
+	}
+	return active
+}
+
+// formatCountdown renders the time left with a precision of minutes, like '2h13m'
+func formatCountdown(left time.Duration) string {
+	if left < time.Minute {
+		return "less than a minute"
+	}
+	return strings.TrimSuffix(left.Truncate(time.Minute).String(), "0s")
+}
+
+// breakGlassDescription lists the active break glass credentials with the time until they expire
+func breakGlassDescription(credentials []*cmv1.BreakGlassCredential, now time.Time) string {
+	if len(credentials) == 0 {
+		return ""
+	}
+	str := "Break Glass Credentials:\n"
+	for _, credential := range credentials {
+		str += fmt.Sprintf(" - %s (%s): expires in %s\n", credential.Username(), credential.ID(),
+			formatCountdown(credential.ExpirationTimestamp().Sub(now)))
+	}
+	return str
+}
+
+func formatBreakGlassCredentials(credentials []*cmv1.BreakGlassCredential, now time.Time) []map[string]interface{} {
+	list := []map[string]interface{}{}
+	for _, credential := range credentials {
+		list = append(list, map[string]interface{}{
+			"id":               credential.ID(),
+			"username":         credential.Username(),
+			"expiresInSeconds": int64(credential.ExpirationTimestamp().Sub(now).Seconds()),
+		})
+	}
+	return list
+}
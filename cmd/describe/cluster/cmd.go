@@ -21,17 +21,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	ocmConsts "github.com/openshift-online/ocm-common/pkg/ocm/consts"
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/color"
+	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/helper/rolepolicybindings"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/properties"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -43,6 +54,12 @@ const (
 
 	EnabledOutput  = "Enabled"
 	DisabledOutput = "Disabled"
+
+	ConfiguredOutput    = "Configured"
+	NotConfiguredOutput = "Not configured"
+
+	PublicOutput  = "Public"
+	PrivateOutput = "Private"
 )
 
 var Cmd = &cobra.Command{
@@ -50,15 +67,135 @@ var Cmd = &cobra.Command{
 	Short: "Show details of a cluster",
 	Long:  "Show details of a cluster",
 	Example: `  # Describe a cluster named "mycluster"
-  rosa describe cluster --cluster=mycluster`,
+  rosa describe cluster --cluster=mycluster
+
+  # Describe several clusters at once
+  rosa describe cluster mycluster1 mycluster2 mycluster3
+
+  # Describe several clusters grouped by region
+  rosa describe cluster mycluster1 mycluster2 mycluster3 --group-by region
+
+  # Describe a cluster as JSON with flat dotted keys, like 'aws.sts.role_arn'
+  rosa describe cluster --cluster=mycluster -o json --json-flatten
+
+  # Describe several clusters as JSON Lines, one cluster per line
+  rosa describe cluster mycluster1 mycluster2 mycluster3 -o jsonl
+
+  # Describe a cluster as JSON using version v1 of the schema
+  rosa describe cluster --cluster=mycluster -o json --output-version v1
+
+  # Look up the subnets of a cluster with the credentials of the "prod" AWS profile
+  rosa describe cluster --cluster=mycluster --show-subnet-cidrs --profile prod
+
+  # Print an approximate command to create a cluster like "mycluster"
+  rosa describe cluster --cluster=mycluster --as-create-command
+
+  # Print the settings of a cluster as Terraform locals, as a starting point for a configuration
+  rosa describe cluster --cluster=mycluster --export-tf
+
+  # Check that the roles, subnets and OIDC endpoint of a cluster are consistent
+  rosa describe cluster --cluster=mycluster --validate
+
+  # Describe a cluster and open its details page in the browser
+  rosa describe cluster --cluster=mycluster --open
+
+  # List the external calls that describing a cluster with a webhook would make
+  rosa describe cluster --cluster=mycluster --webhook https://example.com/hook --dry-run
+
+  # Describe the cluster that the current context of the kubeconfig points to
+  rosa describe cluster --from-kubeconfig
+
+  # Compare the settings of two clusters side by side
+  rosa describe cluster mycluster1 mycluster2 --compare
+
+  # Print only the summary of a cluster
+  rosa describe cluster --cluster=mycluster --summary-only
+
+  # Wait for a cluster to be ready, for up to 45 minutes, and then describe it
+  rosa describe cluster --cluster=mycluster --poll-until-field=state=ready --wait-timeout=45m
+
+  # Describe only the clusters of several that are in region us-east-1 and span several zones
+  rosa describe cluster mycluster1 mycluster2 mycluster3 --select 'region=us-east-1,multi-az=true'
+
+  # Print how many of several clusters exist
+  rosa describe cluster mycluster1 mycluster2 mycluster3 --count-only
+
+  # Check that a cluster exists, using only the exit code
+  rosa describe cluster --cluster=mycluster --count-only
+
+  # Check that the subnets of a cluster have the tags that its load balancers need
+  rosa describe cluster --cluster=mycluster --verify-subnets
+
+  # Check if the policies of the account roles of a cluster can be upgraded
+  rosa describe cluster --cluster=mycluster --check-policy-version
+
+  # Describe a cluster without revealing its OCM environment, to share it in a ticket
+  rosa describe cluster --cluster=mycluster --mask-urls
+
+  # Describe the cluster with the external identifier found in its ClusterVersion resource
+  rosa describe cluster --external-id=2a8e3a4f-5e7c-4b1d-9f0a-6c2d8e1b7a35`,
 	Run:  run,
-	Args: cobra.MaximumNArgs(1),
+	Args: cobra.ArbitraryArgs,
 }
 
 var args struct {
 	getRolePolicyBindings bool
+	redactARNs            bool
+	minimal               bool
+	showSubnetCIDRs       bool
+	showMachinePools      string
+	explainField          string
+	tree                  bool
+	outputVersion         string
+	concurrency           int
+	onlyErrors            bool
+	webhook               string
+	webhookStrict         bool
+	showAddOns            bool
+	formatWidths          string
+	asCreateCommand       bool
+	latency               bool
+	validate              bool
+	groupBy               string
+	diffPools             string
+	showConditions        bool
+	open                  bool
+	support               bool
+	dryRun                bool
+	humanize              bool
+	colorTheme            string
+	externalID            string
+	scalingActivity       bool
+	strictJSON            bool
+	pollUntilField        string
+	waitTimeout           time.Duration
+	summaryOnly           bool
+	fromKubeconfig        bool
+	compare               bool
+	trim                  bool
+	jsonFlatten           bool
+	countOnly             bool
+	maskURLs              bool
+	intervalJitter        time.Duration
+	verifySubnets         bool
+	checkPolicyVersion    bool
+	groupPoolsByVersion   bool
+	exportTF              bool
+	selector              string
+	showAllUpgrades       bool
+	timeout               time.Duration
+	showAccess            bool
+	showIngress           bool
+	showAutoscaler        bool
 }
 
+// Subnets already looked up in AWS, keyed by subnet ID
+var subnetCache = map[string]ec2types.Subnet{}
+var subnetCacheLock sync.Mutex
+
+// Matches the partition, service and region of an ARN followed by a 12 digit account ID
+var arnAccountIDRE = regexp.MustCompile(`(arn:[^:\s]+:[^:\s]*:[^:\s]*:)(\d{12})`)
+
 func init() {
 	output.AddFlag(Cmd)
 	ocm.AddClusterFlag(Cmd)
@@ -69,83 +206,1200 @@ func init() {
 		false,
 		"List the attached policies for the sts roles",
 	)
+
+	Cmd.Flags().BoolVar(
+		&args.redactARNs,
+		"redact-arns",
+		false,
+		"Mask the AWS account IDs in the ARNs of the cluster description",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.minimal,
+		"minimal",
+		false,
+		"Describe the cluster using only the cluster resource, skipping the lookups of the display name, "+
+			"machine pools, scheduled upgrades, limited support reasons and inflight checks. "+
+			"Node counts are taken from the cluster totals.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.showSubnetCIDRs,
+		"show-subnet-cidrs",
+		false,
+		"Look up the CIDR block and availability zone of each cluster subnet in AWS",
+	)
+
+	Cmd.Flags().StringVar(
+		&args.showMachinePools,
+		"show-machine-pools",
+		"",
+		fmt.Sprintf("Show a table of the machine pools of the cluster. Allowed options are %s, "+
+			"where '%s' adds every available column", machinePoolsOptions, machinePoolsWide),
+	)
+	Cmd.Flags().Lookup("show-machine-pools").NoOptDefVal = machinePoolsSummary
+	Cmd.RegisterFlagCompletionFunc("show-machine-pools", machinePoolsCompletion)
+
+	Cmd.Flags().StringVar(
+		&args.explainField,
+		"explain-field",
+		"",
+		"Explain the meaning of a field of the cluster description, such as 'host-prefix', "+
+			"and show its value for the cluster",
+	)
+	Cmd.RegisterFlagCompletionFunc("explain-field", clusterFieldsCompletion)
+
+	Cmd.Flags().BoolVar(
+		&args.tree,
+		"tree",
+		false,
+		"Show a hosted control plane cluster as a tree with its node pools as children",
+	)
+
+	Cmd.Flags().StringVar(
+		&args.outputVersion,
+		"output-version",
+		latestOutputVersion(),
+		fmt.Sprintf("Version of the schema of the JSON and YAML output, so that keys added by newer "+
+			"versions don't break automation. Allowed versions are %s", outputVersionNames()),
+	)
+	Cmd.RegisterFlagCompletionFunc("output-version", outputVersionCompletion)
+
+	Cmd.Flags().IntVar(
+		&args.concurrency,
+		"concurrency",
+		defaultConcurrency,
+		"Number of clusters fetched in parallel when describing several clusters",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.onlyErrors,
+		"only-errors",
+		false,
+		"Print nothing for healthy clusters and only the problems of unhealthy ones, such as the error "+
+			"state, provisioning errors, limited support reasons and availability zone imbalances",
+	)
+
+	Cmd.Flags().StringVar(
+		&args.webhook,
+		"webhook",
+		"",
+		"URL where the JSON description of each cluster is sent with a POST request after it's printed",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.webhookStrict,
+		"webhook-strict",
+		false,
+		"Fail when the webhook can't be reached or doesn't respond with a success status, "+
+			"instead of printing a warning",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.showAddOns,
+		"show-addons",
+		false,
+		"List the add-ons installed on the cluster with their state and version",
+	)
+
+	Cmd.Flags().StringVar(
+		&args.formatWidths,
+		"format-widths",
+		formatWidthsLegacy,
+		fmt.Sprintf("Width of the label column of the description. Allowed options are %s, where '%s' "+
+			"fits the column to the longest label printed", formatWidthsOptions, formatWidthsCompact),
+	)
+	Cmd.RegisterFlagCompletionFunc("format-widths", formatWidthsCompletion)
+
+	Cmd.Flags().BoolVar(
+		&args.asCreateCommand,
+		"as-create-command",
+		false,
+		"Print an approximate 'rosa create cluster' command that would create a cluster like this one",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.latency,
+		"latency",
+		false,
+		"Measure the round-trip time to the API server of the cluster",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.validate,
+		"validate",
+		false,
+		"Run read-only consistency checks of the cluster instead of describing it: the STS roles and "+
+			"subnets exist, the OIDC endpoint is reachable, the CIDRs don't overlap and the version is "+
+			"supported. Fails if any check fails.",
+	)
+
+	Cmd.Flags().StringVar(
+		&args.groupBy,
+		"group-by",
+		"",
+		fmt.Sprintf("Group the descriptions of several clusters under a header with the number of clusters "+
+			"of each group. Allowed options are %s", groupByOptions),
+	)
+	Cmd.RegisterFlagCompletionFunc("group-by", groupByCompletion)
+
+	Cmd.Flags().StringVar(
+		&args.diffPools,
+		"diff-pools",
+		"",
+		"Compare the node pools of a Hosted Control Plane cluster to the given baseline node pool, "+
+			"marking the instance types, versions, labels and taints that differ",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.showConditions,
+		"show-conditions",
+		false,
+		"Show the conditions derived from each field of the status of the cluster, including the "+
+			"ones that the summarized state hides. The cluster resource doesn't have conditions of its own.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.open,
+		"open",
+		false,
+		"Open the details page of the cluster in the default browser after describing it, or its "+
+			"console when there is no details page",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.support,
+		"support",
+		false,
+		"Show information for support engineers, such as the management cluster that hosts the control "+
+			"plane of Hosted Control Plane clusters",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.dryRun,
+		"dry-run",
+		false,
+		"List the calls to AWS, probes of the cluster and webhooks that describing the cluster would make, "+
+			"without making them",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.humanize,
+		"humanize",
+		false,
+		"Format the node counts with thousands separators and short forms for large counts, such as "+
+			"'12.5k'. The JSON output isn't affected",
+	)
+
+	Cmd.Flags().StringVar(
+		&args.colorTheme,
+		"color-theme",
+		color.ThemeNames()[0],
+		fmt.Sprintf("Palette used to highlight the headers, state and warnings of the description, so "+
+			"that it's readable on any terminal background. Colors are only used when enabled by the "+
+			"'--color' option and NO_COLOR isn't set. Allowed options are %s", color.ThemeNames()),
+	)
+	Cmd.RegisterFlagCompletionFunc("color-theme", colorThemeCompletion)
+
+	Cmd.Flags().StringVar(
+		&args.externalID,
+		"external-id",
+		"",
+		"External identifier of the cluster to describe, as known by the cluster itself. It's an "+
+			"alternative to the name or identifier of the cluster, for tools running inside of it.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.scalingActivity,
+		"scaling-activity",
+		false,
+		"Show the scale ups and scale downs of the nodes of autoscaled clusters recorded in the "+
+			"service logs during the last hour.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.strictJSON,
+		"strict-json",
+		false,
+		"Fail instead of writing empty values when the identifier, state, version or region of the "+
+			"cluster is missing from the JSON output, which usually means a partial API response.",
+	)
+
+	Cmd.Flags().StringVar(
+		&args.pollUntilField,
+		"poll-until-field",
+		"",
+		"Wait until a field of the cluster has the given value before describing it, for example "+
+			"'state=ready' or 'compute=6'. The fields are the ones accepted by '--explain-field'.",
+	)
+	Cmd.RegisterFlagCompletionFunc("poll-until-field", pollUntilFieldCompletion)
+
+	Cmd.Flags().DurationVar(
+		&args.waitTimeout,
+		"wait-timeout",
+		time.Hour,
+		"Maximum time to wait for the condition of '--poll-until-field'.",
+	)
+
+	Cmd.Flags().DurationVar(
+		&args.intervalJitter,
+		"interval-jitter",
+		0,
+		"Maximum random delay added to each interval of '--poll-until-field', for example '5s', so that "+
+			"many processes waiting for clusters don't poll at the same time.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.summaryOnly,
+		"summary-only",
+		false,
+		"Print only the summary at the top of the description, with the name, identifier, state, "+
+			"version, region and number of nodes of the cluster.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.fromKubeconfig,
+		"from-kubeconfig",
+		false,
+		"Describe the cluster of the current context of the kubeconfig, found by the URL of its API "+
+			"server. The kubeconfig is the first file of 'KUBECONFIG', or '~/.kube/config'.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.compare,
+		"compare",
+		false,
+		"Compare two or more clusters side by side, with one column per cluster and one row per "+
+			"field. The fields that differ are marked with a '*'.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.trim,
+		"trim",
+		false,
+		"Remove the blank lines before and after the description, so that the output of several "+
+			"commands can be concatenated.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.jsonFlatten,
+		"json-flatten",
+		false,
+		"Flatten the nested objects of the JSON output into dotted keys, like 'aws.sts.role_arn'.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.countOnly,
+		"count-only",
+		false,
+		"Only check that the clusters exist, without describing them. With several clusters it prints "+
+			"the number of clusters that exist. With one cluster it prints nothing, and exits with a "+
+			"non-zero code when the cluster doesn't exist. Failures to get a cluster for other reasons are "+
+			"errors.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.maskURLs,
+		"mask-urls",
+		false,
+		"Replace the host names of the OCM API and of the console with aliases like '<ocm-prod>', so "+
+			"that the description doesn't reveal the environment when shared.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.verifySubnets,
+		"verify-subnets",
+		false,
+		"Check in AWS that the public subnets of the cluster have the 'kubernetes.io/role/elb' tag, and "+
+			"the private ones the 'kubernetes.io/role/internal-elb' tag, that load balancers need.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.checkPolicyVersion,
+		"check-policy-version",
+		false,
+		"Check in AWS the version of the policies of the account roles of STS clusters whose policies "+
+			"aren't managed by AWS, and if there is a newer one to upgrade to.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.groupPoolsByVersion,
+		"group-pools-by-version",
+		false,
+		"Show under the summary how many node pools of hosted control plane clusters run each "+
+			"OpenShift version, to follow the progress of staged upgrades.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.exportTF,
+		"export-tf",
+		false,
+		"Print the region, version, networking and roles of the cluster as Terraform locals. It's a "+
+			"starting point for a Terraform configuration, not import state.",
+	)
+
+	Cmd.Flags().StringVar(
+		&args.selector,
+		"select",
+		"",
+		"Only describe the clusters whose fields have the given values, for example "+
+			"'region=us-east-1,multi-az=true'. The fields are the ones accepted by '--explain-field'.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.showAllUpgrades,
+		"show-all-upgrades",
+		false,
+		"List every scheduled upgrade of the cluster, automatic and manual, with its state, next run "+
+			"and schedule type, instead of only the first one.",
+	)
+
+	Cmd.Flags().DurationVar(
+		&args.timeout,
+		"timeout",
+		time.Minute,
+		"Maximum time to wait for each request that describing the cluster makes, except the ones to AWS. "+
+			"The OCM requests that are rate limited are sent again until it expires, and the probes of the "+
+			"API server and the OIDC endpoint, and the webhook, are abandoned when it does.",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.showAccess,
+		"show-access",
+		false,
+		"Look up the identity providers of the cluster to show if the cluster-admin user is configured, "+
+			"and the URL to log in with",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.showIngress,
+		"show-ingress",
+		false,
+		"Look up the ingresses of the cluster to show the visibility and load balancer type of the default "+
+			"ingress, and the custom ingress domains",
+	)
+
+	Cmd.Flags().BoolVar(
+		&args.showAutoscaler,
+		"show-autoscaler",
+		false,
+		"Look up the cluster autoscaler to show its scale-down settings",
+	)
+}
+
+func outputVersionCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return outputVersionNames(), cobra.ShellCompDirectiveDefault
+}
+
+func formatWidthsCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return formatWidthsOptions, cobra.ShellCompDirectiveDefault
+}
+
+func machinePoolsCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return machinePoolsOptions, cobra.ShellCompDirectiveDefault
 }
 
 func run(cmd *cobra.Command, argv []string) {
-	r := rosa.NewRuntime().WithOCM().WithAWS()
+	r := rosa.NewRuntime().WithOCM()
 	defer r.Cleanup()
+	if args.timeout > 0 {
+		r.OCMClient.SetRateLimitTimeout(args.timeout)
+	}
+	r.WithAWS()
 
-	var err error
+	clusterKeys := argv
+	if args.externalID != "" && args.fromKubeconfig {
+		r.Reporter.Errorf("The '--external-id' flag can't be combined with '--from-kubeconfig'")
+		os.Exit(1)
+	}
+	if args.externalID != "" {
+		if len(argv) > 0 || cmd.Flag("cluster").Changed {
+			r.Reporter.Errorf("The '--external-id' flag can't be combined with a cluster name or identifier")
+			os.Exit(1)
+		}
+		clusterID, err := clusterIDByExternalID(r, args.externalID)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(1)
+		}
+		clusterKeys = []string{clusterID}
+	} else if args.fromKubeconfig {
+		if len(argv) > 0 || cmd.Flag("cluster").Changed {
+			r.Reporter.Errorf("The '--from-kubeconfig' flag can't be combined with a cluster name or identifier")
+			os.Exit(1)
+		}
+		path, err := kubeconfigPath()
+		if err != nil {
+			r.Reporter.Errorf("Failed to find the kubeconfig: %v", err)
+			os.Exit(1)
+		}
+		clusterID, err := clusterIDFromKubeconfig(r, path)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(1)
+		}
+		clusterKeys = []string{clusterID}
+	} else {
+		// Allow the command to be called programmatically
+		if len(argv) == 1 && !cmd.Flag("cluster").Changed {
+			ocm.SetClusterKey(argv[0])
+		}
+		if len(argv) <= 1 {
+			clusterKeys = []string{r.GetClusterKey()}
+		}
+	}
+	for _, clusterKey := range clusterKeys {
+		if !ocm.IsValidClusterKey(clusterKey) {
+			r.Reporter.Errorf("Cluster name, identifier or external identifier '%s' isn't valid: it "+
+				"must contain only letters, digits, dashes and underscores", clusterKey)
+			os.Exit(1)
+		}
+	}
 
-	// Allow the command to be called programmatically
-	if len(argv) == 1 && !cmd.Flag("cluster").Changed {
-		ocm.SetClusterKey(argv[0])
+	err := validateArgs()
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(1)
 	}
-	clusterKey := r.GetClusterKey()
 
-	cluster := r.FetchCluster()
-	isHypershift := cluster.Hypershift().Enabled()
+	var selector []pollCondition
+	if args.selector != "" {
+		selector, _ = parseSelector(args.selector)
+	}
 
-	displayName := ""
-	subscription, subscriptionExists, err := r.OCMClient.GetSubscriptionBySubscriptionID(cluster.Subscription().ID())
+	if args.countOnly {
+		count, err := countClusters(r, clusterKeys, selector)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(1)
+		}
+		if len(clusterKeys) > 1 {
+			fmt.Println(count)
+		} else if count == 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if args.compare && len(clusterKeys) < 2 {
+		r.Reporter.Errorf("The '--compare' flag needs at least two clusters")
+		os.Exit(1)
+	}
+
+	if args.open && len(clusterKeys) > 1 {
+		r.Reporter.Errorf("The '--open' flag can only be used to describe one cluster")
+		os.Exit(1)
+	}
+
+	if args.pollUntilField != "" {
+		if len(clusterKeys) > 1 {
+			r.Reporter.Errorf("The '--poll-until-field' flag can only be used to describe one cluster")
+			os.Exit(1)
+		}
+		condition, _ := parsePollCondition(args.pollUntilField)
+		err = pollUntilField(r, clusterKeys[0], condition, args.waitTimeout, pollInterval, args.intervalJitter)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(1)
+		}
+	}
+
+	if len(clusterKeys) == 1 && args.selector == "" {
+		description, err := describeCluster(r, clusterKeys[0])
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(1)
+		}
+		err = printClusterDescriptions([]*clusterDescription{description})
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(1)
+		}
+		if args.open && !args.dryRun {
+			openClusterPage(r, description.cluster)
+		}
+		if !sendWebhooks(r, []*clusterDescription{description}) || description.failed {
+			os.Exit(1)
+		}
+		return
+	}
+
+	descriptions, err := describeClusters(r, clusterKeys, args.concurrency)
+	printErr := printClusterDescriptions(descriptions)
 	if err != nil {
-		r.Reporter.Debugf("Failed to get subscription by ID: %s", err)
+		r.Reporter.Errorf("%s", err)
+	}
+	if printErr != nil {
+		r.Reporter.Errorf("%s", printErr)
+	}
+	sent := sendWebhooks(r, descriptions)
+	failed := false
+	for _, description := range descriptions {
+		failed = failed || description.failed
+	}
+	if err != nil || printErr != nil || !sent || failed {
+		os.Exit(1)
+	}
+}
+
+// validateArgs checks the combinations of flags before any cluster is fetched
+func validateArgs() error {
+	if args.minimal && args.getRolePolicyBindings {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--get-role-policy-bindings'")
+	}
+	if args.showMachinePools != "" && !helper.Contains(machinePoolsOptions, args.showMachinePools) {
+		return fmt.Errorf("Invalid value '%s' for '--show-machine-pools'. Allowed options are %s",
+			args.showMachinePools, machinePoolsOptions)
+	}
+	if args.minimal && args.showMachinePools != "" {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--show-machine-pools'")
+	}
+	if args.minimal && args.tree {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--tree'")
+	}
+	if args.minimal && args.diffPools != "" {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--diff-pools'")
+	}
+	if args.minimal && args.showAddOns {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--show-addons'")
+	}
+	if args.minimal && args.scalingActivity {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--scaling-activity'")
+	}
+	if args.minimal && args.groupPoolsByVersion {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--group-pools-by-version'")
+	}
+	if args.minimal && args.showAllUpgrades {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--show-all-upgrades'")
+	}
+	if args.minimal && args.checkPolicyVersion {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--check-policy-version'")
+	}
+	if args.minimal && args.showAccess {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--show-access'")
+	}
+	if args.minimal && args.showIngress {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--show-ingress'")
+	}
+	if args.minimal && args.showAutoscaler {
+		return fmt.Errorf("The '--minimal' flag can't be combined with '--show-autoscaler'")
+	}
+	if args.pollUntilField != "" {
+		_, err := parsePollCondition(args.pollUntilField)
+		if err != nil {
+			return err
+		}
+	}
+	if args.selector != "" {
+		_, err := parseSelector(args.selector)
+		if err != nil {
+			return err
+		}
+	}
+	if args.timeout <= 0 {
+		return fmt.Errorf("The value of '--timeout' must be positive")
+	}
+	if args.intervalJitter < 0 {
+		return fmt.Errorf("The value of '--interval-jitter' can't be negative")
+	}
+	if args.intervalJitter > 0 && args.pollUntilField == "" {
+		return fmt.Errorf("The '--interval-jitter' flag can only be used with the '--poll-until-field' flag")
+	}
+	if args.summaryOnly && (output.HasFlag() || args.tree || args.explainField != "" || args.onlyErrors ||
+		args.asCreateCommand || args.validate) {
+		return fmt.Errorf("The '--summary-only' flag can't be combined with '--output', '--tree', " +
+			"'--explain-field', '--only-errors', '--as-create-command' or '--validate'")
+	}
+	if args.compare && (args.tree || args.explainField != "" || args.onlyErrors || args.asCreateCommand ||
+		args.validate || args.summaryOnly || args.groupBy != "" || args.webhook != "") {
+		return fmt.Errorf("The '--compare' flag can't be combined with '--tree', '--explain-field', " +
+			"'--only-errors', '--as-create-command', '--validate', '--summary-only', '--group-by' or '--webhook'")
+	}
+	if args.countOnly && (output.HasFlag() || args.tree || args.explainField != "" || args.onlyErrors ||
+		args.asCreateCommand || args.validate || args.summaryOnly || args.compare || args.webhook != "" ||
+		args.pollUntilField != "" || args.open) {
+		return fmt.Errorf("The '--count-only' flag can't be combined with '--output', '--tree', " +
+			"'--explain-field', '--only-errors', '--as-create-command', '--validate', '--summary-only', " +
+			"'--compare', '--webhook', '--poll-until-field' or '--open'")
+	}
+	if args.jsonFlatten && !isJSONOutput() {
+		return fmt.Errorf("The '--json-flatten' flag can only be used with the '--output' flag")
+	}
+	if args.strictJSON && !isJSONOutput() {
+		return fmt.Errorf("The '--strict-json' flag can only be used with the '--output' flag")
+	}
+	if _, err := color.GetTheme(args.colorTheme); err != nil {
+		return err
+	}
+	if !helper.Contains(formatWidthsOptions, args.formatWidths) {
+		return fmt.Errorf("Invalid value '%s' for '--format-widths'. Allowed options are %s",
+			args.formatWidths, formatWidthsOptions)
+	}
+	if !helper.Contains(outputVersionNames(), args.outputVersion) {
+		return fmt.Errorf("Unknown output version '%s'. Allowed versions are %s",
+			args.outputVersion, outputVersionNames())
+	}
+	if args.explainField != "" {
+		_, err := findClusterField(args.explainField)
+		if err != nil {
+			return err
+		}
+	}
+	if args.onlyErrors && (args.minimal || args.tree || args.explainField != "") {
+		return fmt.Errorf("The '--only-errors' flag can't be combined with '--minimal', '--tree' " +
+			"or '--explain-field'")
+	}
+	if args.webhook != "" {
+		if args.explainField != "" || args.summaryOnly || args.asCreateCommand || args.exportTF {
+			return fmt.Errorf("The '--webhook' flag can't be combined with '--explain-field', " +
+				"'--summary-only', '--as-create-command' or '--export-tf', as they don't describe the cluster as JSON")
+		}
+		err := validateWebhook(args.webhook)
+		if err != nil {
+			return err
+		}
+	}
+	if args.exportTF && (output.HasFlag() || args.tree || args.explainField != "" || args.onlyErrors ||
+		args.asCreateCommand || args.validate || args.summaryOnly || args.compare || args.countOnly) {
+		return fmt.Errorf("The '--export-tf' flag can't be combined with '--output', '--tree', " +
+			"'--explain-field', '--only-errors', '--as-create-command', '--validate', '--summary-only', " +
+			"'--compare' or '--count-only'")
+	}
+	if args.asCreateCommand && (output.HasFlag() || args.tree || args.explainField != "" || args.onlyErrors) {
+		return fmt.Errorf("The '--as-create-command' flag can't be combined with '--output', '--tree', " +
+			"'--explain-field' or '--only-errors'")
 	}
-	if subscriptionExists {
-		displayName = subscription.DisplayName()
+	if args.validate && (args.minimal || args.tree || args.explainField != "" || args.onlyErrors ||
+		args.asCreateCommand) {
+		return fmt.Errorf("The '--validate' flag can't be combined with '--minimal', '--tree', " +
+			"'--explain-field', '--only-errors' or '--as-create-command'")
 	}
+	if args.groupBy != "" && !helper.Contains(groupByOptions, args.groupBy) {
+		return fmt.Errorf("Invalid value '%s' for '--group-by'. Allowed options are %s",
+			args.groupBy, groupByOptions)
+	}
+	if args.groupBy != "" && output.Output() == output.HTML {
+		return fmt.Errorf("The '--group-by' flag can't be combined with the '%s' output format", output.HTML)
+	}
+	if args.concurrency < 1 {
+		return fmt.Errorf("The value of '--concurrency' must be at least 1")
+	}
+	return nil
+}
 
+// clusterDescription is the rendered description of a cluster, either the human readable text or,
+// when an output format is requested, the map to encode
+type clusterDescription struct {
+	cluster *cmv1.Cluster
+	text    string
+	f       map[string]interface{}
+	// failed is set when the validation of the cluster found problems
+	failed bool
+}
+
+// clusterIDByExternalID resolves the external identifier of a cluster to its OCM identifier
+func clusterIDByExternalID(r *rosa.Runtime, externalID string) (string, error) {
+	if !helper.IsValidUUID(externalID) {
+		return "", fmt.Errorf("External identifier '%s' isn't valid: it must be a UUID", externalID)
+	}
+	r.Reporter.Debugf("Loading cluster with external identifier '%s'", externalID)
+	cluster, err := r.OCMClient.GetClusterByExternalID(externalID, r.Creator)
+	if err != nil {
+		return "", fmt.Errorf("Failed to get cluster with external identifier '%s': %v%s",
+			externalID, err, requestContext(err))
+	}
+	return cluster.ID(), nil
+}
+
+// describeCluster fetches the cluster with the given key, and the resources that the description
+// needs, and renders it according to the flags. Clusters that don't match the selector are
+// skipped before any other lookup, returning no description.
+func describeCluster(r *rosa.Runtime, clusterKey string) (*clusterDescription, error) {
+	r.Reporter.Debugf("Loading cluster '%s'", clusterKey)
+	cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get cluster '%s': %v%s", clusterKey, err, requestContext(err))
+	}
+	if args.selector != "" {
+		selector, _ := parseSelector(args.selector)
+		if !matchesSelector(cluster, selector) {
+			r.Reporter.Debugf("Skipping cluster '%s' that doesn't match the selector", clusterKey)
+			return nil, nil
+		}
+	}
+	isHypershift := cluster.Hypershift().Enabled()
+
+	if args.dryRun {
+		calls := plannedExternalCalls(cluster)
+		return &clusterDescription{
+			cluster: cluster,
+			text:    dryRunReport(cluster, calls),
+			f:       formatDryRun(cluster, calls),
+		}, nil
+	}
+	if args.explainField != "" {
+		explainedField, err := findClusterField(args.explainField)
+		if err != nil {
+			return nil, err
+		}
+		return &clusterDescription{
+			cluster: cluster,
+			text:    explainClusterField(explainedField, cluster),
+		}, nil
+	}
+	if args.asCreateCommand {
+		text := createCommandDescription(cluster)
+		if args.redactARNs {
+			text = redactARNs(text)
+		}
+		return &clusterDescription{
+			cluster: cluster,
+			text:    text,
+		}, nil
+	}
+	if args.exportTF {
+		text, err := terraformExport(cluster)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to export cluster '%s' to Terraform: %v", clusterKey, err)
+		}
+		if args.redactARNs {
+			text = redactARNs(text)
+		}
+		return &clusterDescription{
+			cluster: cluster,
+			text:    text,
+		}, nil
+	}
+	if args.validate {
+		checks := validateCluster(r, cluster)
+		return &clusterDescription{
+			cluster: cluster,
+			text:    validationReport(cluster, checks),
+			f:       formatValidation(cluster, checks),
+			failed:  !validationPassed(checks),
+		}, nil
+	}
+	if args.compare {
+		return &clusterDescription{
+			cluster: cluster,
+		}, nil
+	}
+	if args.summaryOnly {
+		return &clusterDescription{
+			cluster: cluster,
+			text:    summaryBanner(cluster),
+		}, nil
+	}
+	if args.tree && !isHypershift {
+		return nil, fmt.Errorf("The '--tree' flag is only supported for Hosted Control Plane clusters")
+	}
+	if args.diffPools != "" && !isHypershift {
+		return nil, fmt.Errorf("The '--diff-pools' flag is only supported for Hosted Control Plane clusters")
+	}
+
+	displayName := ""
+	var subscription *amv1.Subscription
 	var scheduledUpgrade *cmv1.UpgradePolicy
 	var upgradeState *cmv1.UpgradePolicyState
 	var controlPlaneScheduledUpgrade *cmv1.ControlPlaneUpgradePolicy
+	var allUpgrades []pendingUpgrade
+	var machinePools []*cmv1.MachinePool
+	var nodePools []*cmv1.NodePool
 
-	if !isHypershift {
+	if !args.minimal {
+		var subscriptionExists bool
+		subscription, subscriptionExists, err = r.OCMClient.GetSubscriptionWithCapabilities(
+			cluster.Subscription().ID())
+		if err != nil {
+			r.Reporter.Debugf("Failed to get subscription by ID: %s", err)
+		}
+		if subscriptionExists {
+			displayName = subscription.DisplayName()
+		}
+	}
+
+	if args.minimal {
+		r.Reporter.Debugf("Skipping the scheduled upgrades and machine pools of cluster '%s'", clusterKey)
+	} else if !isHypershift && args.showAllUpgrades {
+		var upgradePolicies []*cmv1.UpgradePolicy
+		var upgradeStates map[string]*cmv1.UpgradePolicyState
+		upgradePolicies, upgradeStates, err = r.OCMClient.GetAllUpgradePolicies(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get scheduled upgrades for cluster '%s': %v%s",
+				clusterKey, err, requestContext(err))
+		}
+		scheduledUpgrade = nextUpgradePolicy(upgradePolicies)
+		if scheduledUpgrade != nil {
+			upgradeState = upgradeStates[scheduledUpgrade.ID()]
+		}
+		allUpgrades = clusterUpgrades(upgradePolicies, upgradeStates)
+	} else if !isHypershift {
 		scheduledUpgrade, upgradeState, err = r.OCMClient.GetScheduledUpgrade(cluster.ID())
 		if err != nil {
-			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			return nil, fmt.Errorf("Failed to get scheduled upgrades for cluster '%s': %v%s",
+				clusterKey, err, requestContext(err))
+		}
+	} else if args.showAllUpgrades {
+		var upgradePolicies []*cmv1.ControlPlaneUpgradePolicy
+		upgradePolicies, err = r.OCMClient.GetControlPlaneUpgradePolicies(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get scheduled upgrades for cluster '%s': %v%s",
+				clusterKey, err, requestContext(err))
+		}
+		controlPlaneScheduledUpgrade = nextControlPlaneUpgradePolicy(upgradePolicies)
+		allUpgrades = controlPlaneUpgrades(upgradePolicies)
+	} else {
+		controlPlaneScheduledUpgrade, err = r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get scheduled upgrades for cluster '%s': %v%s",
+				clusterKey, err, requestContext(err))
 		}
+	}
+
+	if !args.minimal {
+		if isHypershift {
+			nodePools, err = r.OCMClient.GetNodePools(cluster.ID())
+		} else {
+			machinePools, err = r.OCMClient.GetMachinePools(cluster.ID())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get machine pools for cluster '%s': %v%s",
+				clusterKey, err, requestContext(err))
+		}
+	}
 
-		if output.HasFlag() {
-			f, err := formatCluster(cluster, scheduledUpgrade, upgradeState, displayName)
+	// The identity providers are fetched once for both the cluster-admin user and the login URL:
+	clusterAdmin := ""
+	login := ""
+	if args.showAccess && cluster.State() == cmv1.ClusterStateReady && !cluster.ExternalAuthConfig().Enabled() {
+		idps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
+		if err != nil {
+			r.Reporter.Debugf("Failed to get the identity providers of cluster '%s': %v", clusterKey, err)
+		} else {
+			hasAdmin, err := hasClusterAdmin(r, cluster, idps)
 			if err != nil {
-				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				r.Reporter.Debugf("Failed to find the cluster-admin user of cluster '%s': %v", clusterKey, err)
+			} else if hasAdmin {
+				clusterAdmin = ConfiguredOutput
+			} else {
+				clusterAdmin = NotConfiguredOutput
 			}
-			err = output.Print(f)
-			if err != nil {
-				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+			if len(idps) > 0 {
+				login, err = loginURL(cluster)
+				if err != nil {
+					r.Reporter.Debugf("Failed to build the login URL of cluster '%s': %v", clusterKey, err)
+				}
 			}
-			return
 		}
-	} else {
-		controlPlaneScheduledUpgrade, err = r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
+	}
+
+	now := time.Now()
+	var breakGlassCredentials []*cmv1.BreakGlassCredential
+	if !args.minimal && cluster.ExternalAuthConfig().Enabled() {
+		credentials, err := r.OCMClient.GetBreakGlassCredentials(cluster.ID())
 		if err != nil {
-			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			r.Reporter.Debugf("Failed to get the break glass credentials of cluster '%s': %v", clusterKey, err)
 		}
+		breakGlassCredentials = activeBreakGlassCredentials(credentials, now)
+	}
 
-		if output.HasFlag() {
-			f, err := formatClusterHypershift(cluster, controlPlaneScheduledUpgrade, displayName)
-			if err != nil {
-				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+	var ingresses []*cmv1.Ingress
+	if args.showIngress {
+		ingresses, err = r.OCMClient.GetIngresses(cluster.ID())
+		if err != nil {
+			r.Reporter.Debugf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	var addOns []*cmv1.AddOnInstallation
+	if args.showAddOns {
+		addOns, err = r.OCMClient.GetAddOnInstallations(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get add-ons for cluster '%s': %v%s", clusterKey, err, requestContext(err))
+		}
+	}
+
+	// The region is only needed for the hint about node pools in a single zone, shown with the pools:
+	regionSupportsMultiAZ := false
+	if isHypershift && len(nodePools) > 0 && args.showMachinePools != "" {
+		region, err := r.OCMClient.GetRegion(cluster.Region().ID())
+		if err != nil {
+			r.Reporter.Debugf("Failed to get region '%s' of cluster '%s': %v", cluster.Region().ID(), clusterKey, err)
+		} else {
+			regionSupportsMultiAZ = region.SupportsMultiAZ()
+		}
+	}
+
+	var baselineNodePool *cmv1.NodePool
+	if args.diffPools != "" {
+		baselineNodePool, err = findNodePool(nodePools, args.diffPools)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The kubelet and tuning configs of the pools are looked up only when the pools are shown:
+	var tuning map[string]poolTuning
+	if args.showMachinePools != "" {
+		tuning, err = lookupPoolsTuning(r, cluster, machinePools, nodePools)
+		if err != nil {
+			r.Reporter.Debugf("Failed to get the node tuning of cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	var latency *apiLatency
+	if args.latency && cluster.API().URL() != "" {
+		latency = &apiLatency{}
+		latency.duration, latency.err = measureAPILatency(cluster.API().URL(), args.timeout)
+		if latency.err != nil {
+			r.Reporter.Debugf("Failed to measure the API latency of cluster '%s': %v", clusterKey, latency.err)
+		}
+	}
+
+	managementCluster := ""
+	if args.support && isHypershift {
+		managementCluster, err = lookupManagementCluster(r, cluster)
+		if err != nil {
+			r.Reporter.Debugf("Failed to get the management cluster of cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	var autoscaler *cmv1.ClusterAutoscaler
+	if args.showAutoscaler && !isHypershift {
+		autoscaler, err = r.OCMClient.GetClusterAutoscaler(cluster.ID())
+		if err != nil {
+			r.Reporter.Debugf("Failed to get the autoscaler of cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	var scalingActivity []scalingEvent
+	if args.scalingActivity && isAutoscaled(cluster, machinePools, nodePools) {
+		scalingActivity, err = lookupScalingActivity(r, cluster, time.Now())
+		if err != nil {
+			r.Reporter.Debugf("Failed to get the scaling activity of cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	var policiesVersion *rolePoliciesVersion
+	if args.checkPolicyVersion && cluster.AWS().STS().RoleARN() != "" && !cluster.AWS().STS().ManagedPolicies() {
+		policiesVersion, err = lookupRolePoliciesVersion(r, cluster)
+		if err != nil {
+			r.Reporter.Debugf("Failed to get the policy version of the roles of cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	var subnets []ec2types.Subnet
+	if args.showSubnetCIDRs && len(cluster.AWS().SubnetIDs()) > 0 {
+		subnets, err = lookupSubnets(r.AWSClient, cluster.AWS().SubnetIDs())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get subnets for cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	var subnetTags []subnetTagCheck
+	if args.verifySubnets && len(cluster.AWS().SubnetIDs()) > 0 {
+		subnetTags, err = verifySubnetTags(r.AWSClient, cluster.AWS().SubnetIDs())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to verify the tags of the subnets of cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	if args.onlyErrors {
+		limitedSupportReasons, err := r.OCMClient.GetLimitedSupportReasons(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get limited support reasons for cluster '%s': %v%s",
+				cluster.ID(), err, requestContext(err))
+		}
+		problems := clusterProblems(cluster, limitedSupportReasons, machinePools, nodePools)
+		if len(problems) == 0 {
+			return &clusterDescription{
+				cluster: cluster,
+			}, nil
+		}
+		text := clusterProblemsReport(cluster, problems)
+		if args.redactARNs {
+			text = redactARNs(text)
+		}
+		if args.maskURLs {
+			text = maskURLs(text)
+		}
+		f := map[string]interface{}{
+			"id":       cluster.ID(),
+			"name":     cluster.Name(),
+			"problems": problems,
+		}
+		redactFormattedCluster(f)
+		return &clusterDescription{
+			cluster: cluster,
+			text:    text,
+			f:       f,
+		}, nil
+	}
+
+	capabilities := enabledCapabilities(subscription)
+	zoneTypes := poolZoneTypes(machinePools, nodePools)
+
+	// The map is also the payload of the webhook, so it's built for the human readable output as well
+	// when a webhook is given:
+	var f map[string]interface{}
+	if isJSONOutput() || args.webhook != "" {
+		if isHypershift {
+			f, err = formatClusterHypershift(cluster, controlPlaneScheduledUpgrade, displayName)
+		} else {
+			f, err = formatCluster(cluster, scheduledUpgrade, upgradeState, displayName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		resourceKeys := map[string]bool{}
+		for key := range f {
+			resourceKeys[key] = true
+		}
+		if len(capabilities) > 0 {
+			f["capabilities"] = capabilities
+		}
+		if clusterAdmin != "" {
+			f["clusterAdminConfigured"] = clusterAdmin == ConfiguredOutput
+		}
+		if login != "" {
+			f["loginUrl"] = login
+		}
+		if imdsv2Required(cluster) {
+			f["imdsv2Required"] = true
+		}
+		f["rootVolumeEncryption"] = formatRootVolumeEncryption(cluster)
+		if len(breakGlassCredentials) > 0 {
+			f["breakGlassCredentials"] = formatBreakGlassCredentials(breakGlassCredentials, now)
+		}
+		if isHypershift {
+			endpoints := map[string]string{
+				"api": listeningVisibility(cluster.API().Listening()),
+			}
+			if ingress := defaultIngress(ingresses); ingress != nil {
+				endpoints["ingress"] = listeningVisibility(ingress.Listening())
+			}
+			f["endpointVisibility"] = endpoints
+		}
+		if ingress := defaultIngress(ingresses); ingress != nil && ingress.LoadBalancerType() != "" {
+			f["defaultIngressLoadBalancer"] = ingress.LoadBalancerType()
+		}
+		if domains := customIngressDomains(ingresses); len(domains) > 0 {
+			f["customIngressDomains"] = domains
+		}
+		if creationMode := cluster.Properties()[properties.CreationMode]; creationMode != "" {
+			f["creationMode"] = creationMode
+		}
+		if len(subnets) > 0 {
+			subnetList := []map[string]string{}
+			for _, subnet := range subnets {
+				subnetList = append(subnetList, map[string]string{
+					"id":               awssdk.ToString(subnet.SubnetId),
+					"cidrBlock":        awssdk.ToString(subnet.CidrBlock),
+					"availabilityZone": awssdk.ToString(subnet.AvailabilityZone),
+				})
 			}
-			err = output.Print(f)
+			f["subnets"] = subnetList
+		}
+		if len(subnetTags) > 0 {
+			f["subnetTags"] = formatSubnetTags(subnetTags)
+		}
+		if args.showAllUpgrades {
+			f["scheduledUpgrades"] = formatScheduledUpgrades(allUpgrades)
+		}
+		if args.groupPoolsByVersion && len(nodePools) > 0 {
+			f["nodePoolVersions"] = nodePoolsByVersion(nodePools)
+		}
+		if len(zoneTypes) > 0 {
+			f["zoneTypes"] = zoneTypes
+		}
+		if storageClass := formatDefaultStorageClass(cluster); storageClass != nil {
+			f["defaultStorageClass"] = storageClass
+		}
+		if partition := awsPartition(cluster); partition != "" {
+			f["awsPartition"] = partition
+		}
+		if len(nodePools) > 0 {
+			f["nodePoolReadiness"] = formatNodePoolsReadiness(nodePools)
+		}
+		if upgradeSettings := nodePoolsUpgradeSettings(nodePools); len(upgradeSettings) > 0 {
+			f["nodePoolUpgradeSettings"] = formatNodePoolsUpgradeSettings(upgradeSettings)
+		}
+		if iops := formatRootVolumeIOPS(machinePools); len(iops) > 0 {
+			f["rootVolumeIOPS"] = iops
+		}
+		if periods := formatNodeDrainGracePeriods(nodePools); len(periods) > 0 {
+			f["nodeDrainGracePeriods"] = periods
+		}
+		if args.showAddOns {
+			f["addOns"] = formatAddOns(addOns)
+		}
+		if latency != nil && latency.err == nil {
+			f["apiLatencyMs"] = latency.duration.Milliseconds()
+		}
+		if subscription.Status() != "" {
+			f["subscriptionStatus"] = subscription.Status()
+		}
+		if len(tuning) > 0 {
+			f["nodeTuning"] = formatPoolsTuning(tuning)
+		}
+		if baselineNodePool != nil {
+			f["nodePoolDifferences"] = nodePoolsDifferences(baselineNodePool, nodePools)
+		}
+		if autoscaler != nil {
+			f["autoscalerScaleDown"] = formatAutoscalerScaleDown(autoscaler)
+		}
+		if len(scalingActivity) > 0 {
+			f["scalingActivity"] = formatScalingActivity(scalingActivity)
+		}
+		if managementCluster != "" {
+			f["managementCluster"] = managementCluster
+		}
+		if policiesVersion != nil {
+			f["policyVersion"] = formatRolePoliciesVersion(policiesVersion)
+		}
+		if args.showConditions {
+			f["derivedConditions"] = formatConditions(clusterConditions(cluster))
+		}
+		f["statusCode"] = statusCode(cluster.State())
+		f["warnings"] = formatWarnings(clusterWarnings(cluster, machinePools, nodePools, regionSupportsMultiAZ))
+		// Explicit booleans, as the cluster resource omits them when they are false:
+		f["fips"] = cluster.FIPS()
+		f["disableUserWorkloadMonitoring"] = cluster.DisableUserWorkloadMonitoring()
+		if args.tree {
+			f["nodePools"], err = formatNodePools(nodePools)
 			if err != nil {
-				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				return nil, err
 			}
-			return
 		}
+		err = pinOutputVersion(f, args.outputVersion, resourceKeys)
+		if err != nil {
+			return nil, err
+		}
+		redactFormattedCluster(f)
+	}
+	if isJSONOutput() {
+		return &clusterDescription{
+			cluster: cluster,
+			f:       f,
+		}, nil
+	}
+
+	if args.tree {
+		return &clusterDescription{
+			cluster: cluster,
+			text:    nodePoolsTree(cluster, nodePools),
+			f:       f,
+		}, nil
 	}
 
 	var str string
 	creatorARN, err := arn.Parse(cluster.Properties()[ocmConsts.CreatorArn])
 	if err != nil {
-		r.Reporter.Errorf("Failed to parse creator ARN for cluster '%s'", clusterKey)
-		os.Exit(1)
+		return nil, fmt.Errorf("Failed to parse creator ARN for cluster '%s'", clusterKey)
+	}
+	awsAccount := creatorARN.AccountID
+	if args.redactARNs {
+		awsAccount = maskAccountID(awsAccount)
 	}
 	phase := ""
 
@@ -195,22 +1449,17 @@ func run(cmd *cobra.Command, argv []string) {
 	}
 
 	subnetsStr := ""
-	if len(cluster.AWS().SubnetIDs()) > 0 {
-		subnetsStr = fmt.Sprintf(" - Subnets:                 %s\n",
-			output.PrintStringSlice(cluster.AWS().SubnetIDs()))
-	}
-
-	var machinePools []*cmv1.MachinePool
-	var nodePools []*cmv1.NodePool
-
-	if isHypershift {
-		nodePools, err = r.OCMClient.GetNodePools(cluster.ID())
-	} else {
-		machinePools, err = r.OCMClient.GetMachinePools(cluster.ID())
-	}
-	if err != nil {
-		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+	if len(subnets) > 0 {
+		subnetsStr = " - Subnets:\n"
+		for _, subnet := range subnets {
+			subnetsStr += fmt.Sprintf("   - %s:	%s (%s)\n",
+				awssdk.ToString(subnet.SubnetId),
+				awssdk.ToString(subnet.CidrBlock),
+				awssdk.ToString(subnet.AvailabilityZone))
+		}
+	} else if len(cluster.AWS().SubnetIDs()) > 0 {
+		subnetsStr = fmt.Sprintf(" - Subnets:                 %s\n",
+			output.PrintStringSlice(cluster.AWS().SubnetIDs()))
 	}
 
 	// Print short cluster description:
@@ -226,7 +1475,9 @@ func run(cmd *cobra.Command, argv []string) {
 		"DNS:                        %s\n"+
 		"AWS Account:                %s\n"+
 		"%s"+
+		"%s"+
 		"API URL:                    %s\n"+
+		"%s"+
 		"Console URL:                %s\n"+
 		"Region:                     %s\n"+
 		"%s"+
@@ -248,12 +1499,14 @@ func run(cmd *cobra.Command, argv []string) {
 		cluster.OpenshiftVersion(),
 		cluster.Version().ChannelGroup(),
 		clusterDNS,
-		creatorARN.AccountID,
+		awsAccount,
+		awsPartitionConfig(cluster),
 		BillingAccount(cluster),
 		cluster.API().URL(),
+		apiLatencyConfig(latency),
 		cluster.Console().URL(),
 		cluster.Region().ID(),
-		clusterMultiAZ(cluster, nodePools),
+		clusterMultiAZ(cluster, nodePools, regionSupportsMultiAZ),
 		clusterInfraConfig(cluster, clusterKey, r, machinePools, nodePools),
 		networkType,
 		cluster.Network().ServiceCIDR(),
@@ -264,29 +1517,32 @@ func run(cmd *cobra.Command, argv []string) {
 		str,
 	)
 
+	str += subnetTagsDescription(subnetTags)
+
+	if len(zoneTypes) > 0 {
+		str = fmt.Sprintf("%s"+"Edge Zones:\n", str)
+		for _, poolID := range sortedKeys(zoneTypes) {
+			str = fmt.Sprintf("%s"+
+				" - %s: %s\n", str, poolID, zoneTypes[poolID])
+		}
+	}
+
+	str += nodePoolsReadiness(nodePools)
+	str += nodePoolsUpgradeDescription(nodePoolsUpgradeSettings(nodePools))
+
 	if cluster.InfraID() != "" {
 		str = fmt.Sprintf("%s"+"Infra ID:                   %s\n", str, cluster.InfraID())
 	}
 
-	if cluster.Proxy() != nil && (cluster.Proxy().HTTPProxy() != "" || cluster.Proxy().HTTPSProxy() != "") {
-		str = fmt.Sprintf("%s"+"Proxy:\n", str)
-		if cluster.Proxy().HTTPProxy() != "" {
-			str = fmt.Sprintf("%s"+
-				" - HTTPProxy:               %s\n", str,
-				cluster.Proxy().HTTPProxy())
-		}
-		if cluster.Proxy().HTTPSProxy() != "" {
-			str = fmt.Sprintf("%s"+
-				" - HTTPSProxy:              %s\n", str,
-				cluster.Proxy().HTTPSProxy())
-		}
-		if cluster.Proxy().NoProxy() != "" {
-			str = fmt.Sprintf("%s"+
-				" - NoProxy:                 %s\n", str,
-				cluster.Proxy().NoProxy())
-		}
+	if instanceType := controlPlaneInstanceType(cluster); instanceType != "" {
+		str = fmt.Sprintf("%s"+"Control Plane Instance:     %s\n", str, instanceType)
+	}
+	if managementCluster != "" {
+		str = fmt.Sprintf("%s"+"Management Cluster:         %s\n", str, managementCluster)
 	}
 
+	str += proxyConfig(cluster)
+
 	if cluster.AdditionalTrustBundle() != "" {
 		str = fmt.Sprintf("%s"+"Additional trust bundle:    REDACTED\n", str)
 	}
@@ -297,14 +1553,16 @@ func run(cmd *cobra.Command, argv []string) {
 		// show default value for clusters that didn't set it.
 		str = fmt.Sprintf("%s"+"EC2 Metadata Http Tokens:   %s\n", str, cmv1.Ec2MetadataHttpTokensOptional)
 	}
+	if imdsv2Required(cluster) {
+		str = fmt.Sprintf("%s"+"Instance Metadata:          IMDSv2 required\n", str)
+	}
 
 	if cluster.AWS().STS().RoleARN() != "" {
 		rolePolicyDetails := map[string][]aws.PolicyDetail{}
 		if args.getRolePolicyBindings {
 			rolePolicyBindings, err := r.OCMClient.ListRolePolicyBindings(cluster.ID(), true)
 			if err != nil {
-				r.Reporter.Errorf("Failed to get rolePolicyBinding: %s", err)
-				os.Exit(1)
+				return nil, fmt.Errorf("Failed to get rolePolicyBinding: %s", err)
 			}
 			rolePolicyDetails = rolepolicybindings.TransformToRolePolicyDetails(rolePolicyBindings)
 		}
@@ -315,8 +1573,7 @@ func run(cmd *cobra.Command, argv []string) {
 			policyStr, err := getRolePolicyBindings(cluster.AWS().STS().RoleARN(), rolePolicyDetails,
 				"                            -")
 			if err != nil {
-				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				return nil, err
 			}
 			str = str + policyStr
 		}
@@ -333,8 +1590,7 @@ func run(cmd *cobra.Command, argv []string) {
 				policyStr, err := getRolePolicyBindings(cluster.AWS().STS().SupportRoleARN(), rolePolicyDetails,
 					"                            -")
 				if err != nil {
-					r.Reporter.Errorf(err.Error())
-					os.Exit(1)
+					return nil, err
 				}
 				str = str + policyStr
 			}
@@ -351,8 +1607,7 @@ func run(cmd *cobra.Command, argv []string) {
 						rolePolicyDetails,
 						"                            -")
 					if err != nil {
-						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						return nil, err
 					}
 					str = str + policyStr
 				}
@@ -366,8 +1621,7 @@ func run(cmd *cobra.Command, argv []string) {
 						rolePolicyDetails,
 						"                            -")
 					if err != nil {
-						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						return nil, err
 					}
 					str = str + policyStr
 				}
@@ -384,8 +1638,7 @@ func run(cmd *cobra.Command, argv []string) {
 						rolePolicyDetails,
 						"   -")
 					if err != nil {
-						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						return nil, err
 					}
 					str = str + policyStr
 				}
@@ -397,6 +1650,10 @@ func run(cmd *cobra.Command, argv []string) {
 			awsManaged = output.Yes
 		}
 		str = fmt.Sprintf("%sManaged Policies:           %s\n", str, awsManaged)
+		if creationMode := cluster.Properties()[properties.CreationMode]; creationMode != "" {
+			str = fmt.Sprintf("%sCreation Mode:              %s\n", str, creationMode)
+		}
+		str += rolePoliciesVersionConfig(policiesVersion)
 	}
 
 	deleteProtection := DisabledOutput
@@ -415,22 +1672,66 @@ func run(cmd *cobra.Command, argv []string) {
 		deleteProtection,
 		cluster.CreationTimestamp().Format("Jan _2 2006 15:04:05 MST"))
 
+	if isHypershift {
+		str = fmt.Sprintf("%s"+
+			"API Endpoint:               %s\n",
+			str,
+			listeningVisibility(cluster.API().Listening()))
+		if ingress := defaultIngress(ingresses); ingress != nil {
+			str = fmt.Sprintf("%s"+
+				"Ingress:                    %s\n",
+				str,
+				listeningVisibility(ingress.Listening()))
+		}
+	}
+	str += defaultIngressConfig(ingresses)
+	if domains := customIngressDomains(ingresses); len(domains) > 0 {
+		str = fmt.Sprintf("%s"+"Custom Ingress Domains:\n", str)
+		for _, domain := range domains {
+			str = fmt.Sprintf("%s"+" - %s\n", str, domain)
+		}
+	}
+
 	str = fmt.Sprintf("%s"+
 		"User Workload Monitoring:   %s\n",
 		str,
 		getUseworkloadMonitoring(cluster.DisableUserWorkloadMonitoring()))
 
+	if clusterAdmin != "" {
+		str = fmt.Sprintf("%s"+
+			"Cluster Admin:              %s\n",
+			str,
+			clusterAdmin)
+	}
+	if login != "" {
+		str = fmt.Sprintf("%s"+"Login URL:                  %s\n", str, login)
+	}
+	str += breakGlassDescription(breakGlassCredentials, now)
+
 	if cluster.FIPS() {
 		str = fmt.Sprintf("%s"+
 			"FIPS mode:                  %s\n",
 			str,
 			EnabledOutput)
 	}
+	str += defaultStorageClassConfig(cluster)
+	if len(capabilities) > 0 {
+		str = fmt.Sprintf("%s"+"Capabilities:\n", str)
+		for _, capability := range capabilities {
+			str = fmt.Sprintf("%s"+
+				" - %s\n", str, capability)
+		}
+	}
 	if detailsPage != "" {
 		str = fmt.Sprintf("%s"+
 			"Details Page:               %s%s\n", str,
 			detailsPage, cluster.Subscription().ID())
 	}
+	if subscription.Status() != "" {
+		str = fmt.Sprintf("%s"+
+			"Subscription Status:        %s\n", str,
+			subscription.Status())
+	}
 	managementType := "Classic"
 	if cluster.AWS().STS().OidcConfig() != nil {
 		managementType = "Unmanaged"
@@ -443,16 +1744,10 @@ func run(cmd *cobra.Command, argv []string) {
 			"OIDC Endpoint URL:          %s (%s)\n", str,
 			cluster.AWS().STS().OIDCEndpointURL(), managementType)
 	}
-	if cluster.AWS().PrivateHostedZoneID() != "" {
-		str = fmt.Sprintf("%s"+"Private Hosted Zone:\n", str)
-		str = fmt.Sprintf("%s"+
-			" - ID:                      %s\n", str,
-			cluster.AWS().PrivateHostedZoneID())
-		str = fmt.Sprintf("%s"+
-			" - Role ARN:                %s\n", str,
-			cluster.AWS().PrivateHostedZoneRoleARN())
-	}
-	if !isHypershift {
+	str += privateHostedZoneConfig(cluster)
+	if args.showAllUpgrades {
+		str += scheduledUpgradesDescription(allUpgrades)
+	} else if !isHypershift {
 		if scheduledUpgrade != nil {
 			str = fmt.Sprintf("%s"+
 				"Scheduled Upgrade:          %s %s on %s\n",
@@ -500,12 +1795,42 @@ func run(cmd *cobra.Command, argv []string) {
 			cluster.Status().ProvisionErrorMessage(),
 		)
 	}
+	if args.showConditions {
+		str = fmt.Sprintf("%s"+"Conditions Derived From Status:\n%s", str,
+			conditionsTable(clusterConditions(cluster)))
+	}
 
-	limitedSupportReasons, err := r.OCMClient.GetLimitedSupportReasons(cluster.ID())
-	if err != nil {
-		r.Reporter.Errorf("Failed to get limited support reasons for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+	var limitedSupportReasons []*cmv1.LimitedSupportReason
+	var inflightChecks []*cmv1.InflightCheck
+	if !args.minimal {
+		limitedSupportReasons, err = r.OCMClient.GetLimitedSupportReasons(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get limited support reasons for cluster '%s': %v%s",
+				cluster.ID(), err, requestContext(err))
+		}
+		inflightChecks, err = r.OCMClient.GetInflightChecks(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get inflight checks for cluster '%s': %v%s",
+				cluster.ID(), err, requestContext(err))
+		}
+	}
+	if args.showMachinePools != "" {
+		str = fmt.Sprintf("%s"+"Machine Pools:\n%s", str,
+			machinePoolsTable(cluster, machinePools, nodePools, args.showMachinePools == machinePoolsWide))
 	}
+	if baselineNodePool != nil {
+		str = fmt.Sprintf("%s"+"Node Pool Differences:\n%s", str, nodePoolsDiffTable(baselineNodePool, nodePools))
+	}
+	if args.showAddOns {
+		str = fmt.Sprintf("%s"+"%s", str, addOnsDescription(addOns))
+	}
+	str += poolsTuningDescription(tuning)
+	str += autoscalerDescription(autoscaler)
+	str += scalingActivityDescription(scalingActivity)
+	str += noSchedulablePool(machinePools, nodePools)
+	str += defaultPoolsTaints(machinePools, nodePools)
+	str += nodePoolsVersionSkew(cluster, nodePools)
+
 	if len(limitedSupportReasons) > 0 {
 		str = fmt.Sprintf("%s"+"Limited Support:\n", str)
 	}
@@ -516,11 +1841,6 @@ func run(cmd *cobra.Command, argv []string) {
 			str, reason.Summary(), reason.Details())
 	}
 
-	inflightChecks, err := r.OCMClient.GetInflightChecks(cluster.ID())
-	if err != nil {
-		r.Reporter.Errorf("Failed to get inflight checks for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
-	}
 	if len(inflightChecks) > 0 {
 		summaries := []string{}
 		for _, inflight := range inflightChecks {
@@ -549,10 +1869,172 @@ func run(cmd *cobra.Command, argv []string) {
 		}
 	}
 
+	if args.groupPoolsByVersion {
+		str = nodePoolVersionsDescription(nodePoolsByVersion(nodePools)) + str
+	}
+	str = summaryBanner(cluster) + str
 	str = fmt.Sprintf("%s\n", str)
 
-	// Print short cluster description:
-	fmt.Print(str)
+	if args.redactARNs {
+		str = redactARNs(str)
+	}
+	if args.maskURLs {
+		str = maskURLs(str)
+	}
+	if args.formatWidths == formatWidthsCompact {
+		str = compactLabelWidths(str)
+	}
+	if !output.HasFlag() && color.UseTheme() {
+		theme, _ := color.GetTheme(args.colorTheme)
+		str = colorizeDescription(str, theme)
+	}
+
+	return &clusterDescription{
+		cluster: cluster,
+		text:    str,
+		f:       f,
+	}, nil
+}
+
+// requestContext returns the status code and operation ID of the failed OCM request, if any, so
+// that they can be referenced in support tickets
+func requestContext(err error) string {
+	details := ocm.GetRequestDetails(err)
+	if details == nil {
+		return ""
+	}
+	context := []string{}
+	if details.Status != 0 {
+		context = append(context, fmt.Sprintf("status %d", details.Status))
+	}
+	if details.OperationID != "" {
+		context = append(context, fmt.Sprintf("operation ID '%s'", details.OperationID))
+	}
+	return fmt.Sprintf(" (%s)", strings.Join(context, ", "))
+}
+
+// isJSONOutput checks if the descriptions are encoded from their maps, rather than rendered as text
+func isJSONOutput() bool {
+	return output.HasFlag() && output.Output() != output.HTML
+}
+
+// printClusterDescriptions prints the descriptions of one or more clusters, as a list when an output
+// format is requested for more than one cluster
+func printClusterDescriptions(descriptions []*clusterDescription) error {
+	if args.compare {
+		return printComparison(descriptions)
+	}
+	if args.groupBy != "" {
+		return printGroupedClusterDescriptions(descriptions)
+	}
+	if isJSONOutput() && args.explainField == "" {
+		if len(descriptions) == 1 && !args.onlyErrors {
+			return printOutput(descriptions[0].f)
+		}
+		// Healthy clusters have no description when only the errors are requested:
+		list := []map[string]interface{}{}
+		for _, description := range descriptions {
+			if description.f != nil {
+				list = append(list, description.f)
+			}
+		}
+		if len(list) == 0 && args.onlyErrors {
+			return nil
+		}
+		return printOutput(list)
+	}
+
+	for _, description := range descriptions {
+		if description.text == "" {
+			continue
+		}
+		if output.Output() == output.HTML {
+			err := output.PrintHTML(fmt.Sprintf("Cluster %s", description.cluster.Name()), description.text)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		// Print short cluster description:
+		fmt.Print(trimDescription(description.text))
+	}
+	return nil
+}
+
+// printOutput prints the resource in the requested output format, flattening it first when
+// '--json-flatten' is used
+func printOutput(resource interface{}) error {
+	if args.jsonFlatten {
+		var err error
+		resource, err = output.Flatten(resource)
+		if err != nil {
+			return err
+		}
+	}
+	return output.Print(resource)
+}
+
+// printComparison prints the fields of the described clusters side by side
+func printComparison(descriptions []*clusterDescription) error {
+	if len(descriptions) < 2 {
+		return fmt.Errorf("Failed to describe enough clusters to compare them")
+	}
+	clusters := []*cmv1.Cluster{}
+	for _, description := range descriptions {
+		clusters = append(clusters, description.cluster)
+	}
+	if isJSONOutput() {
+		return printOutput(formatCompare(clusters))
+	}
+	if output.Output() == output.HTML {
+		return output.PrintHTML("Cluster comparison", compareTable(clusters))
+	}
+	fmt.Print(compareTable(clusters))
+	return nil
+}
+
+// trimDescription removes the surrounding blank lines of the description when '--trim' is used,
+// keeping the final line break
+func trimDescription(text string) string {
+	if !args.trim {
+		return text
+	}
+	return strings.TrimSpace(text) + "\n"
+}
+
+// printGroupedClusterDescriptions prints the descriptions under a header per group. The JSON
+// output is a map from the group to the list of cluster descriptions.
+func printGroupedClusterDescriptions(descriptions []*clusterDescription) error {
+	// Healthy clusters have no description when only the errors are requested:
+	shown := []*clusterDescription{}
+	for _, description := range descriptions {
+		if (isJSONOutput() && description.f != nil) || (!isJSONOutput() && description.text != "") {
+			shown = append(shown, description)
+		}
+	}
+	groups, grouped := groupDescriptions(shown, args.groupBy)
+	if isJSONOutput() {
+		if len(groups) == 0 && args.onlyErrors {
+			return nil
+		}
+		f := map[string][]map[string]interface{}{}
+		for _, group := range groups {
+			for _, description := range grouped[group] {
+				f[group] = append(f[group], description.f)
+			}
+		}
+		return printOutput(f)
+	}
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Print("\n")
+		}
+		fmt.Print(groupHeader(args.groupBy, group, len(grouped[group])))
+		for _, description := range grouped[group] {
+			fmt.Print(trimDescription(description.text))
+		}
+	}
+	return nil
 }
 
 var mapInflightErrorTypeToTitle = map[string]string{
@@ -622,7 +2104,17 @@ func controlPlaneConfig(cluster *cmv1.Cluster) string {
 	return "Customer Hosted"
 }
 
-func clusterMultiAZ(cluster *cmv1.Cluster, nodePools []*cmv1.NodePool) string {
+// controlPlaneType returns 'hcp' or 'classic', matching the human readable 'Control Plane' line
+func controlPlaneType(cluster *cmv1.Cluster) string {
+	if cluster.Hypershift().Enabled() {
+		return "hcp"
+	}
+	return "classic"
+}
+
+// clusterMultiAZ describes the availability of the cluster. For hosted control plane clusters it
+// notes when every node pool landed in the same zone although the region has several.
+func clusterMultiAZ(cluster *cmv1.Cluster, nodePools []*cmv1.NodePool, regionSupportsMultiAZ bool) string {
 	var multiaz string
 	if cluster.Hypershift().Enabled() {
 		dataPlaneAvailability := "SingleAZ"
@@ -631,6 +2123,12 @@ func clusterMultiAZ(cluster *cmv1.Cluster, nodePools []*cmv1.NodePool) string {
 			for _, nodePool := range nodePools {
 				multiAzMap[nodePool.AvailabilityZone()] = struct{}{}
 			}
+			// Without the node pools fall back to the zones recorded in the cluster
+			if len(nodePools) == 0 {
+				for _, availabilityZone := range cluster.Nodes().AvailabilityZones() {
+					multiAzMap[availabilityZone] = struct{}{}
+				}
+			}
 			if len(multiAzMap) > 1 {
 				dataPlaneAvailability = "MultiAZ"
 			}
@@ -639,16 +2137,35 @@ func clusterMultiAZ(cluster *cmv1.Cluster, nodePools []*cmv1.NodePool) string {
 			" - Control Plane:           MultiAZ\n"+
 			" - Data Plane:              %s\n",
 			dataPlaneAvailability)
+		if note := singleZoneDataPlane(cluster, nodePools, regionSupportsMultiAZ); note != "" {
+			multiaz += fmt.Sprintf("   NOTE: %s\n", note)
+		}
 	} else {
 		multiaz = fmt.Sprintf("Multi-AZ:                   %t\n", cluster.MultiAZ())
 	}
 	return multiaz
 }
 
+// mixesScalingModes returns true when some of the machine pools autoscale and others have a fixed
+// number of replicas, as then the compute totals add up both
+func mixesScalingModes(machinePools []*cmv1.MachinePool) bool {
+	autoscaled, fixed := false, false
+	for _, machinePool := range machinePools {
+		if machinePool.Autoscaling() != nil {
+			autoscaled = true
+		} else {
+			fixed = true
+		}
+	}
+	return autoscaled && fixed
+}
+
 func clusterInfraConfig(cluster *cmv1.Cluster, clusterKey string, r *rosa.Runtime,
 	machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) string {
 	var nodeConfig string
-	if cluster.Hypershift().Enabled() {
+	if args.minimal {
+		nodeConfig = clusterNodesConfig(cluster)
+	} else if cluster.Hypershift().Enabled() {
 		minNodes := 0
 		maxNodes := 0
 		currentNodes := 0
@@ -668,21 +2185,21 @@ func clusterInfraConfig(cluster *cmv1.Cluster, clusterKey string, r *rosa.Runtim
 		if minNodes != maxNodes {
 			nodeConfig = fmt.Sprintf(`
 Nodes:
- - Compute (Autoscaled):    %d-%d
- - Compute (current):       %d
+ - Compute (Autoscaled):    %s-%s
+ - Compute (current):       %s
 `,
-				minNodes,
-				maxNodes,
-				currentNodes,
+				formatCount(minNodes),
+				formatCount(maxNodes),
+				formatCount(currentNodes),
 			)
 		} else {
 			nodeConfig = fmt.Sprintf(`
 Nodes:
- - Compute (desired):       %d
- - Compute (current):       %d
+ - Compute (desired):       %s
+ - Compute (current):       %s
 `,
-				maxNodes,
-				currentNodes,
+				formatCount(maxNodes),
+				formatCount(currentNodes),
 			)
 		}
 	} else {
@@ -702,24 +2219,28 @@ Nodes:
 
 		nodeConfig = fmt.Sprintf(`
 Nodes:
- - Control plane:           %d
- - Infra:                   %d
+ - Control plane:           %s
+ - Infra:                   %s
 `,
-			cluster.Nodes().Master(),
-			cluster.Nodes().Infra())
+			formatCount(cluster.Nodes().Master()),
+			formatCount(cluster.Nodes().Infra()))
 
 		// Determine whether there is any auto-scaling in the cluster
 		if minNodes == maxNodes {
 			nodeConfig += fmt.Sprintf(
-				" - Compute:                 %d\n",
-				minNodes,
+				" - Compute:                 %s\n",
+				formatCount(minNodes),
 			)
 		} else {
 			nodeConfig += fmt.Sprintf(
-				" - Compute (Autoscaled):    %d-%d\n",
-				minNodes, maxNodes,
+				" - Compute (Autoscaled):    %s-%s\n",
+				formatCount(minNodes), formatCount(maxNodes),
 			)
 		}
+		if mixesScalingModes(machinePools) {
+			nodeConfig += "   NOTE: mixed: some pools autoscale, and the fixed replicas of the other pools " +
+				"are added to both ends of the range\n"
+		}
 	}
 	hasSgsControlPlane := len(cluster.AWS().AdditionalControlPlaneSecurityGroupIds()) > 0
 	hasSgsInfra := len(cluster.AWS().AdditionalInfraSecurityGroupIds()) > 0
@@ -741,6 +2262,165 @@ Nodes:
 	return nodeConfig
 }
 
+// enabledCapabilities returns the names of the capabilities enabled for the cluster subscription
+func enabledCapabilities(subscription *amv1.Subscription) []string {
+	capabilities := []string{}
+	if subscription == nil {
+		return capabilities
+	}
+	for _, capability := range subscription.Capabilities() {
+		if capability.Value() == "true" {
+			capabilities = append(capabilities, capability.Name())
+		}
+	}
+	return capabilities
+}
+
+// poolZoneTypes returns the zone types of the machine or node pools placed in Local Zones,
+// Wavelength Zones or Outposts, keyed by pool ID. Pools in standard zones are omitted.
+func poolZoneTypes(machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) map[string]string {
+	zoneTypes := map[string]string{}
+	for _, machinePool := range machinePools {
+		if zoneType := edgeZoneType(machinePool.AWS().AvailabilityZoneTypes(),
+			machinePool.AWS().SubnetOutposts()); zoneType != "" {
+			zoneTypes[machinePool.ID()] = zoneType
+		}
+	}
+	for _, nodePool := range nodePools {
+		if zoneType := edgeZoneType(nodePool.AWSNodePool().AvailabilityZoneTypes(),
+			nodePool.AWSNodePool().SubnetOutposts()); zoneType != "" {
+			zoneTypes[nodePool.ID()] = zoneType
+		}
+	}
+	return zoneTypes
+}
+
+func edgeZoneType(availabilityZoneTypes map[string]string, subnetOutposts map[string]string) string {
+	types := map[string]struct{}{}
+	for _, zoneType := range availabilityZoneTypes {
+		if zoneType != "" && zoneType != "availability-zone" {
+			types[zoneType] = struct{}{}
+		}
+	}
+	if len(subnetOutposts) > 0 {
+		types["outpost"] = struct{}{}
+	}
+	return strings.Join(sortedKeys(types), ", ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := helper.MapKeys(m)
+	sort.Strings(keys)
+	return keys
+}
+
+// clusterNodesConfig describes the nodes of the cluster using the totals of the cluster resource,
+// for when the machine pools weren't fetched
+func clusterNodesConfig(cluster *cmv1.Cluster) string {
+	nodeConfig := "\nNodes:\n"
+	if !cluster.Hypershift().Enabled() {
+		nodeConfig += fmt.Sprintf(
+			" - Control plane:           %s\n"+
+				" - Infra:                   %s\n",
+			formatCount(cluster.Nodes().Master()),
+			formatCount(cluster.Nodes().Infra()))
+	}
+	if cluster.Nodes().AutoscaleCompute() != nil {
+		nodeConfig += fmt.Sprintf(
+			" - Compute (Autoscaled):    %s-%s\n",
+			formatCount(cluster.Nodes().AutoscaleCompute().MinReplicas()),
+			formatCount(cluster.Nodes().AutoscaleCompute().MaxReplicas()))
+	} else {
+		nodeConfig += fmt.Sprintf(
+			" - Compute:                 %s\n",
+			formatCount(cluster.Nodes().Compute()))
+	}
+	return nodeConfig
+}
+
+// lookupSubnets returns the AWS subnets with the given IDs, in the same order, only querying AWS
+// for the subnets that weren't looked up before
+func lookupSubnets(awsClient aws.Client, subnetIDs []string) ([]ec2types.Subnet, error) {
+	subnetCacheLock.Lock()
+	defer subnetCacheLock.Unlock()
+
+	missing := []string{}
+	for _, subnetID := range subnetIDs {
+		if _, ok := subnetCache[subnetID]; !ok {
+			missing = append(missing, subnetID)
+		}
+	}
+	if len(missing) > 0 {
+		found, err := awsClient.ListSubnets(missing...)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range found {
+			subnetCache[awssdk.ToString(subnet.SubnetId)] = subnet
+		}
+	}
+	subnets := []ec2types.Subnet{}
+	for _, subnetID := range subnetIDs {
+		subnet, ok := subnetCache[subnetID]
+		if !ok {
+			return nil, fmt.Errorf("Subnet '%s' not found", subnetID)
+		}
+		subnets = append(subnets, subnet)
+	}
+	return subnets, nil
+}
+
+// listeningVisibility describes whether an endpoint with the given listening method is reachable
+// from the internet
+func listeningVisibility(listening cmv1.ListeningMethod) string {
+	if listening == cmv1.ListeningMethodInternal {
+		return PrivateOutput
+	}
+	return PublicOutput
+}
+
+func defaultIngress(ingresses []*cmv1.Ingress) *cmv1.Ingress {
+	for _, ingress := range ingresses {
+		if ingress.Default() {
+			return ingress
+		}
+	}
+	return nil
+}
+
+// defaultIngressConfig shows the type of the load balancer of the default ingress, as services that
+// need a specific type, and the quota of load balancers of the account, depend on it
+func defaultIngressConfig(ingresses []*cmv1.Ingress) string {
+	ingress := defaultIngress(ingresses)
+	if ingress == nil || ingress.LoadBalancerType() == "" {
+		return ""
+	}
+	return fmt.Sprintf("Default Ingress:\n"+
+		" - Load Balancer:           %s\n",
+		loadBalancerTypeName(ingress.LoadBalancerType()))
+}
+
+func loadBalancerTypeName(flavor cmv1.LoadBalancerFlavor) string {
+	switch flavor {
+	case cmv1.LoadBalancerFlavorClassic:
+		return "Classic (CLB)"
+	case cmv1.LoadBalancerFlavorNlb:
+		return "Network (NLB)"
+	}
+	return string(flavor)
+}
+
+// customIngressDomains returns the domains served by the additional ingress controllers
+func customIngressDomains(ingresses []*cmv1.Ingress) []string {
+	domains := []string{}
+	for _, ingress := range ingresses {
+		if !ingress.Default() && ingress.DNSName() != "" {
+			domains = append(domains, ingress.DNSName())
+		}
+	}
+	return domains
+}
+
 func getDetailsLink(environment string) string {
 	switch environment {
 	case StageEnv:
@@ -772,6 +2452,12 @@ func formatCluster(cluster *cmv1.Cluster, scheduledUpgrade *cmv1.UpgradePolicy,
 	if err != nil {
 		return nil, err
 	}
+	if args.strictJSON {
+		err = checkCoreFields(cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if scheduledUpgrade != nil &&
 		upgradeState != nil &&
 		len(scheduledUpgrade.Version()) > 0 &&
@@ -783,10 +2469,42 @@ func formatCluster(cluster *cmv1.Cluster, scheduledUpgrade *cmv1.UpgradePolicy,
 		ret["scheduledUpgrade"] = upgrade
 	}
 	ret["displayName"] = displayName
+	ret["controlPlaneType"] = controlPlaneType(cluster)
+	if instanceType := controlPlaneInstanceType(cluster); instanceType != "" {
+		ret["controlPlaneInstanceType"] = instanceType
+	}
 
 	return ret, nil
 }
 
+// checkCoreFields fails when any of the fields that the JSON output always needs is empty
+func checkCoreFields(cluster *cmv1.Cluster) error {
+	missing := []string{}
+	for _, field := range [][2]string{
+		{"id", cluster.ID()},
+		{"state", string(cluster.State())},
+		{"version", cluster.OpenshiftVersion()},
+		{"region", cluster.Region().ID()},
+	} {
+		if field[1] == "" {
+			missing = append(missing, field[0])
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("The cluster is missing required fields: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// controlPlaneInstanceType returns the instance type of the control plane nodes of classic
+// clusters, hosted control planes don't run on instances of the customer
+func controlPlaneInstanceType(cluster *cmv1.Cluster) string {
+	if cluster.Hypershift().Enabled() {
+		return ""
+	}
+	return cluster.Nodes().MasterMachineType().ID()
+}
+
 func formatClusterHypershift(cluster *cmv1.Cluster,
 	scheduledUpgrade *cmv1.ControlPlaneUpgradePolicy,
 	displayName string) (map[string]interface{}, error) {
@@ -801,6 +2519,12 @@ func formatClusterHypershift(cluster *cmv1.Cluster,
 	if err != nil {
 		return nil, err
 	}
+	if args.strictJSON {
+		err = checkCoreFields(cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if scheduledUpgrade != nil &&
 		scheduledUpgrade.State() != nil &&
 		len(scheduledUpgrade.Version()) > 0 &&
@@ -812,10 +2536,87 @@ func formatClusterHypershift(cluster *cmv1.Cluster,
 		ret["scheduledUpgrade"] = upgrade
 	}
 	ret["display_name"] = displayName
+	ret["controlPlaneType"] = controlPlaneType(cluster)
 
 	return ret, nil
 }
 
+// awsPartition returns the AWS partition of the cluster, such as 'aws' or 'aws-us-gov', taken from
+// the ARN of the creator or of the installer role
+func awsPartition(cluster *cmv1.Cluster) string {
+	for _, roleARN := range []string{cluster.Properties()[ocmConsts.CreatorArn], cluster.AWS().STS().RoleARN()} {
+		parsed, err := arn.Parse(roleARN)
+		if err == nil {
+			return parsed.Partition
+		}
+	}
+	return ""
+}
+
+// imdsv2Required returns true when the instances of the cluster, the workers included, only accept
+// session tokens for the instance metadata service, that is IMDSv2
+func imdsv2Required(cluster *cmv1.Cluster) bool {
+	return cluster.AWS().Ec2MetadataHttpTokens() == cmv1.Ec2MetadataHttpTokensRequired
+}
+
+// formatRootVolumeEncryption describes the key that encrypts the root volumes of the nodes. There
+// is no key per pool: all of them use the KMS key given when the cluster was created, or the AWS
+// managed key of EBS when there is none.
+func formatRootVolumeEncryption(cluster *cmv1.Cluster) map[string]interface{} {
+	if cluster.AWS().KMSKeyArn() == "" {
+		return map[string]interface{}{
+			"keyType": "aws_managed",
+		}
+	}
+	return map[string]interface{}{
+		"keyType":   "customer_managed",
+		"kmsKeyArn": cluster.AWS().KMSKeyArn(),
+	}
+}
+
+// proxyConfig shows the cluster-wide proxy. The additional trust bundle is also the trusted CA of
+// the proxy, so that relationship is made explicit when both are configured.
+func proxyConfig(cluster *cmv1.Cluster) string {
+	proxy := cluster.Proxy()
+	if proxy.HTTPProxy() == "" && proxy.HTTPSProxy() == "" {
+		return ""
+	}
+	str := "Proxy:\n"
+	if proxy.HTTPProxy() != "" {
+		str += fmt.Sprintf(" - HTTPProxy:               %s\n", proxy.HTTPProxy())
+	}
+	if proxy.HTTPSProxy() != "" {
+		str += fmt.Sprintf(" - HTTPSProxy:              %s\n", proxy.HTTPSProxy())
+	}
+	if proxy.NoProxy() != "" {
+		str += fmt.Sprintf(" - NoProxy:                 %s\n", proxy.NoProxy())
+	}
+	if cluster.AdditionalTrustBundle() != "" {
+		str += " - Trust Bundle:            used for proxy TLS\n"
+	}
+	return str
+}
+
+// privateHostedZoneConfig shows the hosted zone of shared VPC clusters, which lives in the account
+// that owns the VPC
+func privateHostedZoneConfig(cluster *cmv1.Cluster) string {
+	if cluster.AWS().PrivateHostedZoneID() == "" {
+		return ""
+	}
+	str := "Private Hosted Zone:\n"
+	str += fmt.Sprintf(" - ID:                      %s\n", cluster.AWS().PrivateHostedZoneID())
+	str += fmt.Sprintf(" - Role ARN:                %s\n", cluster.AWS().PrivateHostedZoneRoleARN())
+	return str
+}
+
+func awsPartitionConfig(cluster *cmv1.Cluster) string {
+	partition := awsPartition(cluster)
+	if partition == "" {
+		return ""
+	}
+	return fmt.Sprintf("AWS Partition:              %s\n", partition)
+}
+
 func BillingAccount(cluster *cmv1.Cluster) string {
 	if cluster.AWS().BillingAccountID() == "" {
 		return ""
@@ -839,6 +2640,113 @@ func getExternalAuthConfigStatus(cluster *cmv1.Cluster) string {
 	return externalAuthConfigStatus
 }
 
+// redactARNs masks the account ID of every ARN found in the given string
+func redactARNs(str string) string {
+	return arnAccountIDRE.ReplaceAllStringFunc(str, func(match string) string {
+		parts := arnAccountIDRE.FindStringSubmatch(match)
+		return parts[1] + maskAccountID(parts[2])
+	})
+}
+
+// redactARNsInMap masks the account ID of every ARN found in the string values of the given
+// formatted cluster
+func redactARNsInMap(m map[string]interface{}) {
+	replaceStringsInMap(m, redactARNs)
+}
+
+// redactFormattedCluster masks the account IDs of the ARNs and the URLs of the given formatted
+// cluster, as requested by the flags
+func redactFormattedCluster(f map[string]interface{}) {
+	if args.redactARNs {
+		redactARNsInMap(f)
+	}
+	if args.maskURLs {
+		replaceStringsInMap(f, maskURLs)
+	}
+}
+
+// replaceStringsInMap applies the replace function to the string values of the given formatted
+// cluster, descending into nested values of any type, including the typed ones of the formatters
+func replaceStringsInMap(m map[string]interface{}, replace func(string) string) {
+	for key, value := range m {
+		m[key] = replaceStringsInValue(value, replace)
+	}
+}
+
+func replaceStringsInValue(value interface{}, replace func(string) string) interface{} {
+	if value == nil {
+		return nil
+	}
+	return replaceStrings(reflect.ValueOf(value), replace).Interface()
+}
+
+// replaceStrings returns a copy of the given value with the replace function applied to all its
+// strings. Maps, slices and pointers are copied, as they may be shared with the cluster resource.
+func replaceStrings(value reflect.Value, replace func(string) string) reflect.Value {
+	switch value.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(replace(value.String())).Convert(value.Type())
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.New(value.Type()).Elem()
+		result.Set(replaceStrings(value.Elem(), replace))
+		return result
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.New(value.Type().Elem())
+		result.Elem().Set(replaceStrings(value.Elem(), replace))
+		return result
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), replaceStrings(iter.Value(), replace))
+		}
+		return result
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(replaceStrings(value.Index(i), replace))
+		}
+		return result
+	case reflect.Array:
+		result := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(replaceStrings(value.Index(i), replace))
+		}
+		return result
+	case reflect.Struct:
+		result := reflect.New(value.Type()).Elem()
+		result.Set(value)
+		for i := 0; i < result.NumField(); i++ {
+			if result.Field(i).CanSet() {
+				result.Field(i).Set(replaceStrings(result.Field(i), replace))
+			}
+		}
+		return result
+	}
+	return value
+}
+
+// maskAccountID replaces all but the last four digits of an AWS account ID, so that
+// redacted ARNs of different accounts can still be told apart
+func maskAccountID(accountID string) string {
+	if len(accountID) <= 4 {
+		return accountID
+	}
+	return strings.Repeat("*", len(accountID)-4) + accountID[len(accountID)-4:]
+}
+
 func getRolePolicyBindings(roleARN string, rolePolicyDetails map[string][]aws.PolicyDetail,
 	prefix string) (string, error) {
 	roleName, err := aws.GetResourceIdFromARN(roleARN)
@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// fieldDiffers returns true when the field doesn't have the same value in all the clusters
+func fieldDiffers(field clusterField, clusters []*cmv1.Cluster) bool {
+	for _, cluster := range clusters[1:] {
+		if field.value(cluster) != field.value(clusters[0]) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareTable renders the fields of the clusters side by side, one column per cluster. The
+// fields that differ are marked with a '*'.
+func compareTable(clusters []*cmv1.Cluster) string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprint(writer, "FIELD\t")
+	for _, cluster := range clusters {
+		fmt.Fprintf(writer, "%s\t", cluster.Name())
+	}
+	fmt.Fprint(writer, "\n")
+	for _, field := range clusterFields {
+		title := field.title
+		if fieldDiffers(field, clusters) {
+			title = "*" + title
+		}
+		fmt.Fprintf(writer, "%s\t", title)
+		for _, cluster := range clusters {
+			value := field.value(cluster)
+			if value == "" {
+				value = "-"
+			}
+			fmt.Fprintf(writer, "%s\t", value)
+		}
+		fmt.Fprint(writer, "\n")
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// formatCompare returns the values of the fields of each cluster, keyed by field and then by the
+// name of the cluster, along with the names of the fields that differ
+func formatCompare(clusters []*cmv1.Cluster) map[string]interface{} {
+	fields := map[string]map[string]string{}
+	differences := []string{}
+	for _, field := range clusterFields {
+		values := map[string]string{}
+		for _, cluster := range clusters {
+			values[cluster.Name()] = field.value(cluster)
+		}
+		fields[field.name] = values
+		if fieldDiffers(field, clusters) {
+			differences = append(differences, field.name)
+		}
+	}
+	return map[string]interface{}{
+		"fields":      fields,
+		"differences": differences,
+	}
+}
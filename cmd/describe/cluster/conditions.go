@@ -0,0 +1,116 @@
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// statusCondition is a condition derived from a field of the status of the cluster, in the usual
+// type/status/reason/message shape
+type statusCondition struct {
+	conditionType string
+	status        bool
+	reason        string
+	message       string
+}
+
+// clusterConditions returns the conditions derived from the status of the cluster. The cluster
+// resource doesn't have a list of conditions, so each one comes from a field of the status,
+// including the ones that the summarized phase of the state hides.
+func clusterConditions(cluster *cmv1.Cluster) []statusCondition {
+	status := cluster.Status()
+	ready := statusCondition{
+		conditionType: "Ready",
+		status:        status.State() == cmv1.ClusterStateReady,
+		reason:        string(status.State()),
+		message:       status.Description(),
+	}
+	dnsReady := statusCondition{
+		conditionType: "DNSReady",
+		status:        status.DNSReady(),
+	}
+	oidcReady := statusCondition{
+		conditionType: "OIDCReady",
+		status:        status.OIDCReady(),
+	}
+	provisionFailed := statusCondition{
+		conditionType: "ProvisionFailed",
+		status:        status.ProvisionErrorCode() != "" || status.ProvisionErrorMessage() != "",
+		reason:        status.ProvisionErrorCode(),
+		message:       status.ProvisionErrorMessage(),
+	}
+	limitedSupport := statusCondition{
+		conditionType: "LimitedSupport",
+		status:        status.LimitedSupportReasonCount() > 0,
+	}
+	if limitedSupport.status {
+		limitedSupport.message = fmt.Sprintf("%d limited support reasons", status.LimitedSupportReasonCount())
+	}
+	readOnly := statusCondition{
+		conditionType: "ReadOnly",
+		status:        status.ConfigurationMode() == cmv1.ClusterConfigurationModeReadOnly,
+		reason:        string(status.ConfigurationMode()),
+	}
+	return []statusCondition{ready, dnsReady, oidcReady, provisionFailed, limitedSupport, readOnly}
+}
+
+func conditionStatus(condition statusCondition) string {
+	if condition.status {
+		return "True"
+	}
+	return "False"
+}
+
+// conditionsTable renders the conditions as a table
+func conditionsTable(conditions []statusCondition) string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprint(writer, "TYPE\tSTATUS\tREASON\tMESSAGE\n")
+	for _, condition := range conditions {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", condition.conditionType, conditionStatus(condition),
+			condition.reason, condition.message)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func formatConditions(conditions []statusCondition) []map[string]string {
+	list := []map[string]string{}
+	for _, condition := range conditions {
+		list = append(list, map[string]string{
+			"type":    condition.conditionType,
+			"status":  conditionStatus(condition),
+			"reason":  condition.reason,
+			"message": condition.message,
+		})
+	}
+	return list
+}
+
+// statusCodes are the stable codes of the states of the cluster in the 'statusCode' key of the
+// JSON output, so that automation doesn't need to match the state strings. The codes must never
+// change, new states are added at the end.
+var statusCodes = map[cmv1.ClusterState]int{
+	cmv1.ClusterStateReady:        0,
+	cmv1.ClusterStateInstalling:   1,
+	cmv1.ClusterStateError:        2,
+	cmv1.ClusterStateWaiting:      3,
+	cmv1.ClusterStatePending:      4,
+	cmv1.ClusterStateValidating:   5,
+	cmv1.ClusterStateUninstalling: 6,
+	cmv1.ClusterStateHibernating:  7,
+	cmv1.ClusterStatePoweringDown: 8,
+	cmv1.ClusterStateResuming:     9,
+	cmv1.ClusterStateUnknown:      10,
+}
+
+// statusCode returns the code of the state of the cluster, states without a code are unknown
+func statusCode(state cmv1.ClusterState) int {
+	if code, ok := statusCodes[state]; ok {
+		return code
+	}
+	return statusCodes[cmv1.ClusterStateUnknown]
+}
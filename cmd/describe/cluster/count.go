@@ -0,0 +1,31 @@
+package cluster
+
+import (
+	"fmt"
+
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// countClusters returns how many of the given clusters exist and match the conditions of the
+// selector, if any. It only fetches the cluster resources, so it's much cheaper than describing
+// them. Clusters that don't exist aren't counted, and any other failure to fetch a cluster is an
+// error, as the count would be wrong.
+func countClusters(r *rosa.Runtime, clusterKeys []string, conditions []pollCondition) (int, error) {
+	count := 0
+	for _, clusterKey := range clusterKeys {
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if errors.GetType(err) == errors.NotFound {
+			r.Reporter.Debugf("Cluster '%s' doesn't exist: %v", clusterKey, err)
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("Failed to get cluster '%s': %v%s", clusterKey, err, requestContext(err))
+		}
+		if matchesSelector(cluster, conditions) {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,147 @@
+package cluster
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// Matches the values that can be written in a shell command without quotes
+var shellSafeRE = regexp.MustCompile(`^[\w@%+=:,./-]+$`)
+
+// shellQuote quotes the value for a POSIX shell, unless it's safe to write it bare
+func shellQuote(value string) string {
+	if shellSafeRE.MatchString(value) {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'"'"'`) + "'"
+}
+
+// createCommand reconstructs a 'rosa create cluster' command line that would create a cluster like
+// the given one. It's only approximate: settings that the cluster resource doesn't keep, such as
+// the additional trust bundle or the cluster admin password, can't be reproduced.
+func createCommand(cluster *cmv1.Cluster) string {
+	isHypershift := cluster.Hypershift().Enabled()
+
+	command := "rosa create cluster"
+	command += fmt.Sprintf(" --cluster-name %s", shellQuote(cluster.Name()))
+	if cluster.DomainPrefix() != "" {
+		command += fmt.Sprintf(" --domain-prefix %s", shellQuote(cluster.DomainPrefix()))
+	}
+
+	sts := cluster.AWS().STS()
+	if sts.RoleARN() != "" {
+		command += " --sts"
+		command += fmt.Sprintf(" --role-arn %s", shellQuote(sts.RoleARN()))
+		command += fmt.Sprintf(" --support-role-arn %s", shellQuote(sts.SupportRoleARN()))
+		if !isHypershift {
+			command += fmt.Sprintf(" --controlplane-iam-role %s",
+				shellQuote(sts.InstanceIAMRoles().MasterRoleARN()))
+		}
+		command += fmt.Sprintf(" --worker-iam-role %s", shellQuote(sts.InstanceIAMRoles().WorkerRoleARN()))
+		if sts.OperatorRolePrefix() != "" {
+			command += fmt.Sprintf(" --operator-roles-prefix %s", shellQuote(sts.OperatorRolePrefix()))
+		}
+		if sts.OidcConfig().ID() != "" {
+			command += fmt.Sprintf(" --oidc-config-id %s", shellQuote(sts.OidcConfig().ID()))
+		}
+	}
+	if cluster.MultiAZ() && !isHypershift {
+		command += " --multi-az"
+	}
+	command += fmt.Sprintf(" --region %s", shellQuote(cluster.Region().ID()))
+	if channelGroup := cluster.Version().ChannelGroup(); channelGroup != "" &&
+		channelGroup != ocm.DefaultChannelGroup {
+		command += fmt.Sprintf(" --channel-group %s", shellQuote(channelGroup))
+	}
+	if cluster.OpenshiftVersion() != "" {
+		command += fmt.Sprintf(" --version %s", shellQuote(cluster.OpenshiftVersion()))
+	}
+
+	if autoscaling := cluster.Nodes().AutoscaleCompute(); autoscaling != nil {
+		command += " --enable-autoscaling"
+		command += fmt.Sprintf(" --min-replicas %d", autoscaling.MinReplicas())
+		command += fmt.Sprintf(" --max-replicas %d", autoscaling.MaxReplicas())
+	} else if cluster.Nodes().Compute() != 0 {
+		command += fmt.Sprintf(" --replicas %d", cluster.Nodes().Compute())
+	}
+	if machineType := cluster.Nodes().ComputeMachineType().ID(); machineType != "" {
+		command += fmt.Sprintf(" --compute-machine-type %s", shellQuote(machineType))
+	}
+
+	if cluster.Network().Type() != "" {
+		command += fmt.Sprintf(" --network-type %s", shellQuote(cluster.Network().Type()))
+	}
+	if cluster.Network().MachineCIDR() != "" {
+		command += fmt.Sprintf(" --machine-cidr %s", shellQuote(cluster.Network().MachineCIDR()))
+	}
+	if cluster.Network().ServiceCIDR() != "" {
+		command += fmt.Sprintf(" --service-cidr %s", shellQuote(cluster.Network().ServiceCIDR()))
+	}
+	if cluster.Network().PodCIDR() != "" {
+		command += fmt.Sprintf(" --pod-cidr %s", shellQuote(cluster.Network().PodCIDR()))
+	}
+	if cluster.Network().HostPrefix() != 0 {
+		command += fmt.Sprintf(" --host-prefix %d", cluster.Network().HostPrefix())
+	}
+	if cluster.AWS().PrivateLink() {
+		command += " --private-link"
+	} else if cluster.API().Listening() == cmv1.ListeningMethodInternal {
+		command += " --private"
+	}
+	if len(cluster.AWS().SubnetIDs()) > 0 {
+		command += fmt.Sprintf(" --subnet-ids %s", shellQuote(strings.Join(cluster.AWS().SubnetIDs(), ",")))
+	}
+	if cluster.AWS().PrivateHostedZoneID() != "" {
+		command += fmt.Sprintf(" --private-hosted-zone-id %s", shellQuote(cluster.AWS().PrivateHostedZoneID()))
+		command += fmt.Sprintf(" --shared-vpc-role-arn %s",
+			shellQuote(cluster.AWS().PrivateHostedZoneRoleARN()))
+		command += fmt.Sprintf(" --base-domain %s", shellQuote(cluster.DNS().BaseDomain()))
+	}
+	if cluster.FIPS() {
+		command += " --fips"
+	} else if cluster.EtcdEncryption() {
+		command += " --etcd-encryption"
+		if cluster.AWS().EtcdEncryption().KMSKeyARN() != "" {
+			command += fmt.Sprintf(" --etcd-encryption-kms-arn %s",
+				shellQuote(cluster.AWS().EtcdEncryption().KMSKeyARN()))
+		}
+	}
+
+	if cluster.Proxy().HTTPProxy() != "" {
+		command += fmt.Sprintf(" --http-proxy %s", shellQuote(cluster.Proxy().HTTPProxy()))
+	}
+	if cluster.Proxy().HTTPSProxy() != "" {
+		command += fmt.Sprintf(" --https-proxy %s", shellQuote(cluster.Proxy().HTTPSProxy()))
+	}
+	if cluster.Proxy().NoProxy() != "" {
+		command += fmt.Sprintf(" --no-proxy %s", shellQuote(cluster.Proxy().NoProxy()))
+	}
+	if cluster.AWS().KMSKeyArn() != "" {
+		command += fmt.Sprintf(" --kms-key-arn %s", shellQuote(cluster.AWS().KMSKeyArn()))
+	}
+	if cluster.DisableUserWorkloadMonitoring() {
+		command += " --disable-workload-monitoring"
+	}
+	if isHypershift {
+		command += " --hosted-cp"
+		if cluster.AWS().BillingAccountID() != "" {
+			command += fmt.Sprintf(" --billing-account %s", shellQuote(cluster.AWS().BillingAccountID()))
+		}
+	}
+	if cluster.AWS().AuditLog().RoleArn() != "" {
+		command += fmt.Sprintf(" --audit-log-arn %s", shellQuote(cluster.AWS().AuditLog().RoleArn()))
+	}
+	return command
+}
+
+// createCommandDescription prints the reconstructed command, making it clear that it's approximate
+func createCommandDescription(cluster *cmv1.Cluster) string {
+	return fmt.Sprintf("# Approximate command to create a cluster like '%s'. Review it before running it,\n"+
+		"# as settings that the cluster doesn't keep, such as the additional trust bundle, are missing.\n"+
+		"%s\n", cluster.Name(), createCommand(cluster))
+}
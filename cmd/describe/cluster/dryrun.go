@@ -0,0 +1,121 @@
+package cluster
+
+import (
+	"fmt"
+	"net/url"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// externalCall is a call that describing the cluster makes outside of OCM
+type externalCall struct {
+	kind        string
+	description string
+}
+
+// plannedExternalCalls returns the calls to AWS, to the cluster and to other services that
+// describing the cluster with the given flags would make. Identifying the AWS account and reading
+// the cluster from OCM aren't included, as they are needed to find the cluster.
+func plannedExternalCalls(cluster *cmv1.Cluster) []externalCall {
+	calls := []externalCall{}
+	sts := cluster.AWS().STS()
+	if args.checkPolicyVersion && sts.RoleARN() != "" && !sts.ManagedPolicies() {
+		calls = append(calls, externalCall{
+			kind:        "AWS",
+			description: "IAM GetRole and ListPolicyTags on the account roles to find the policy version",
+		})
+	}
+	if args.showSubnetCIDRs && len(cluster.AWS().SubnetIDs()) > 0 {
+		calls = append(calls, externalCall{
+			kind:        "AWS",
+			description: fmt.Sprintf("EC2 DescribeSubnets on %d subnets", len(cluster.AWS().SubnetIDs())),
+		})
+	}
+	if args.validate {
+		if roleARNs := clusterRoleARNs(cluster); len(roleARNs) > 0 {
+			calls = append(calls, externalCall{
+				kind:        "AWS",
+				description: fmt.Sprintf("IAM GetRole on %d roles", len(roleARNs)),
+			})
+		}
+		if len(cluster.AWS().SubnetIDs()) > 0 && !args.showSubnetCIDRs {
+			calls = append(calls, externalCall{
+				kind:        "AWS",
+				description: fmt.Sprintf("EC2 DescribeSubnets on %d subnets", len(cluster.AWS().SubnetIDs())),
+			})
+		}
+		if oidcEndpointURL := sts.OIDCEndpointURL(); oidcEndpointURL != "" {
+			calls = append(calls, externalCall{
+				kind:        "Probe",
+				description: fmt.Sprintf("HTTPS GET of the discovery document of '%s'", oidcEndpointURL),
+			})
+		}
+	}
+	// The subnets looked up for the CIDRs or the validation are reused to check the tags:
+	if args.verifySubnets && len(cluster.AWS().SubnetIDs()) > 0 {
+		if !args.showSubnetCIDRs && !args.validate {
+			calls = append(calls, externalCall{
+				kind:        "AWS",
+				description: fmt.Sprintf("EC2 DescribeSubnets on %d subnets", len(cluster.AWS().SubnetIDs())),
+			})
+		}
+		calls = append(calls, externalCall{
+			kind:        "AWS",
+			description: "EC2 DescribeRouteTables of the subnets to find the public ones",
+		})
+	}
+	if args.latency && cluster.API().URL() != "" {
+		calls = append(calls, externalCall{
+			kind:        "Probe",
+			description: fmt.Sprintf("TCP connection to the API server '%s'", cluster.API().URL()),
+		})
+	}
+	if args.webhook != "" {
+		calls = append(calls, externalCall{
+			kind:        "Webhook",
+			description: fmt.Sprintf("HTTP POST of the description to '%s'", webhookHost(args.webhook)),
+		})
+	}
+	if args.open {
+		calls = append(calls, externalCall{
+			kind:        "Browser",
+			description: "Open the details page of the cluster",
+		})
+	}
+	return calls
+}
+
+// webhookHost returns the host of the webhook, as the rest of the URL may contain secrets
+func webhookHost(webhookURL string) string {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || parsed.Host == "" {
+		return webhookURL
+	}
+	return parsed.Scheme + "://" + parsed.Host
+}
+
+func dryRunReport(cluster *cmv1.Cluster, calls []externalCall) string {
+	str := fmt.Sprintf("Describing cluster '%s' (%s) would make these external calls:\n", cluster.Name(), cluster.ID())
+	if len(calls) == 0 {
+		return str + " - None\n"
+	}
+	for _, call := range calls {
+		str += fmt.Sprintf(" - %s: %s\n", call.kind, call.description)
+	}
+	return str
+}
+
+func formatDryRun(cluster *cmv1.Cluster, calls []externalCall) map[string]interface{} {
+	list := []map[string]string{}
+	for _, call := range calls {
+		list = append(list, map[string]string{
+			"kind":        call.kind,
+			"description": call.description,
+		})
+	}
+	return map[string]interface{}{
+		"id":    cluster.ID(),
+		"name":  cluster.Name(),
+		"calls": list,
+	}
+}
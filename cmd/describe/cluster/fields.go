@@ -0,0 +1,229 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+// clusterField is a field of the cluster description that can be referenced by name from the
+// command line
+type clusterField struct {
+	name        string
+	title       string
+	description string
+	value       func(*cmv1.Cluster) string
+}
+
+var clusterFields = []clusterField{
+	{
+		name:        "name",
+		title:       "Name",
+		description: "Name of the cluster, unique within the organization.",
+		value:       (*cmv1.Cluster).Name,
+	},
+	{
+		name:        "id",
+		title:       "ID",
+		description: "Identifier assigned to the cluster by OpenShift Cluster Manager.",
+		value:       (*cmv1.Cluster).ID,
+	},
+	{
+		name:        "external-id",
+		title:       "External ID",
+		description: "Identifier that the cluster reports to the telemetry of Red Hat.",
+		value:       (*cmv1.Cluster).ExternalID,
+	},
+	{
+		name:  "control-plane",
+		title: "Control Plane",
+		description: "Where the control plane runs: on instances of the customer account for classic " +
+			"clusters, or in the service account for hosted control plane clusters.",
+		value: controlPlaneConfig,
+	},
+	{
+		name:        "state",
+		title:       "State",
+		description: "Lifecycle state of the cluster, such as installing, ready or uninstalling.",
+		value: func(cluster *cmv1.Cluster) string {
+			return string(cluster.State())
+		},
+	},
+	{
+		name:        "openshift-version",
+		title:       "OpenShift Version",
+		description: "Version of OpenShift running on the cluster.",
+		value:       (*cmv1.Cluster).OpenshiftVersion,
+	},
+	{
+		name:        "channel-group",
+		title:       "Channel Group",
+		description: "Channel group that determines the versions the cluster can be upgraded to.",
+		value: func(cluster *cmv1.Cluster) string {
+			return cluster.Version().ChannelGroup()
+		},
+	},
+	{
+		name:        "region",
+		title:       "Region",
+		description: "AWS region where the cluster is installed.",
+		value: func(cluster *cmv1.Cluster) string {
+			return cluster.Region().ID()
+		},
+	},
+	{
+		name:        "multi-az",
+		title:       "Multi-AZ",
+		description: "Whether the nodes of the cluster are spread across multiple availability zones.",
+		value: func(cluster *cmv1.Cluster) string {
+			return output.PrintBool(cluster.MultiAZ())
+		},
+	},
+	{
+		name:        "private",
+		title:       "Private",
+		description: "Whether the API of the cluster is only reachable from within the VPC.",
+		value: func(cluster *cmv1.Cluster) string {
+			return output.PrintBool(cluster.API().Listening() == cmv1.ListeningMethodInternal)
+		},
+	},
+	{
+		name:        "api-url",
+		title:       "API URL",
+		description: "URL of the Kubernetes API server of the cluster.",
+		value: func(cluster *cmv1.Cluster) string {
+			return cluster.API().URL()
+		},
+	},
+	{
+		name:        "console-url",
+		title:       "Console URL",
+		description: "URL of the OpenShift web console of the cluster.",
+		value: func(cluster *cmv1.Cluster) string {
+			return cluster.Console().URL()
+		},
+	},
+	{
+		name:        "network-type",
+		title:       "Network Type",
+		description: "Network plugin that implements the pod network, such as OVNKubernetes.",
+		value: func(cluster *cmv1.Cluster) string {
+			return cluster.Network().Type()
+		},
+	},
+	{
+		name:        "machine-cidr",
+		title:       "Machine CIDR",
+		description: "Range of IP addresses of the VPC subnets where the nodes run.",
+		value: func(cluster *cmv1.Cluster) string {
+			return cluster.Network().MachineCIDR()
+		},
+	},
+	{
+		name:        "service-cidr",
+		title:       "Service CIDR",
+		description: "Range of IP addresses assigned to the Kubernetes services of the cluster.",
+		value: func(cluster *cmv1.Cluster) string {
+			return cluster.Network().ServiceCIDR()
+		},
+	},
+	{
+		name:        "pod-cidr",
+		title:       "Pod CIDR",
+		description: "Range of IP addresses assigned to the pods of the cluster.",
+		value: func(cluster *cmv1.Cluster) string {
+			return cluster.Network().PodCIDR()
+		},
+	},
+	{
+		name:  "host-prefix",
+		title: "Host Prefix",
+		description: "Size of the subnet of the pod CIDR assigned to each node. A /23 prefix gives " +
+			"each node 510 pod IP addresses.",
+		value: func(cluster *cmv1.Cluster) string {
+			return fmt.Sprintf("/%d", cluster.Network().HostPrefix())
+		},
+	},
+	{
+		name:  "control-plane-nodes",
+		title: "Control plane",
+		description: "Number of nodes running the control plane of classic clusters, such as the " +
+			"API server and etcd.",
+		value: func(cluster *cmv1.Cluster) string {
+			return fmt.Sprintf("%d", cluster.Nodes().Master())
+		},
+	},
+	{
+		name:  "infra",
+		title: "Infra",
+		description: "Number of nodes of classic clusters dedicated to infrastructure components " +
+			"such as the router, the image registry and monitoring, so that they don't compete " +
+			"with the workloads.",
+		value: func(cluster *cmv1.Cluster) string {
+			return fmt.Sprintf("%d", cluster.Nodes().Infra())
+		},
+	},
+	{
+		name:        "compute",
+		title:       "Compute",
+		description: "Number of worker nodes that run the workloads of the cluster.",
+		value: func(cluster *cmv1.Cluster) string {
+			if autoscaling := cluster.Nodes().AutoscaleCompute(); autoscaling != nil {
+				return fmt.Sprintf("%d-%d", autoscaling.MinReplicas(), autoscaling.MaxReplicas())
+			}
+			return fmt.Sprintf("%d", cluster.Nodes().Compute())
+		},
+	},
+	{
+		name:        "infra-id",
+		title:       "Infra ID",
+		description: "Identifier used to name and tag the cloud resources of the cluster.",
+		value:       (*cmv1.Cluster).InfraID,
+	},
+	{
+		name:        "fips",
+		title:       "FIPS mode",
+		description: "Whether the cluster only uses FIPS validated cryptographic modules.",
+		value: func(cluster *cmv1.Cluster) string {
+			return output.PrintBool(cluster.FIPS())
+		},
+	},
+}
+
+func clusterFieldNames() []string {
+	names := []string{}
+	for _, field := range clusterFields {
+		names = append(names, field.name)
+	}
+	return names
+}
+
+func findClusterField(name string) (clusterField, error) {
+	for _, field := range clusterFields {
+		if field.name == name {
+			return field, nil
+		}
+	}
+	return clusterField{}, fmt.Errorf("Unknown field '%s'. Allowed fields are %s",
+		name, strings.Join(clusterFieldNames(), ", "))
+}
+
+// explainClusterField describes the given field followed by its value for the cluster
+func explainClusterField(field clusterField, cluster *cmv1.Cluster) string {
+	return fmt.Sprintf("%s:\n"+
+		"  %s\n"+
+		"\n"+
+		"Value: %s\n",
+		field.title,
+		field.description,
+		field.value(cluster),
+	)
+}
+
+func clusterFieldsCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return clusterFieldNames(), cobra.ShellCompDirectiveDefault
+}
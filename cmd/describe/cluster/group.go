@@ -0,0 +1,55 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+)
+
+const (
+	groupByRegion  = "region"
+	groupByVersion = "version"
+)
+
+var groupByOptions = []string{groupByRegion, groupByVersion}
+
+func clusterGroup(cluster *cmv1.Cluster, groupBy string) string {
+	switch groupBy {
+	case groupByRegion:
+		return cluster.Region().ID()
+	case groupByVersion:
+		return cluster.OpenshiftVersion()
+	}
+	return ""
+}
+
+// groupDescriptions groups the descriptions of the clusters by region or version, keeping the
+// order of the clusters within each group. The groups are returned sorted.
+func groupDescriptions(descriptions []*clusterDescription,
+	groupBy string) ([]string, map[string][]*clusterDescription) {
+	grouped := map[string][]*clusterDescription{}
+	for _, description := range descriptions {
+		group := clusterGroup(description.cluster, groupBy)
+		grouped[group] = append(grouped[group], description)
+	}
+	return sortedKeys(grouped), grouped
+}
+
+// groupHeader introduces the descriptions of a group with its subtotal
+func groupHeader(groupBy string, group string, count int) string {
+	clusters := "clusters"
+	if count == 1 {
+		clusters = "cluster"
+	}
+	return fmt.Sprintf("%s %s (%d %s):\n", groupByTitles[groupBy], group, count, clusters)
+}
+
+var groupByTitles = map[string]string{
+	groupByRegion:  "Region",
+	groupByVersion: "Version",
+}
+
+func groupByCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return groupByOptions, cobra.ShellCompDirectiveDefault
+}
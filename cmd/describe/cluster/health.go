@@ -0,0 +1,208 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	semver "github.com/hashicorp/go-version"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// maxNodePoolVersionSkew is the number of minor versions that the node pools of hosted control
+// planes are supported to lag behind the control plane
+const maxNodePoolVersionSkew = 2
+
+// clusterProblems evaluates the health of the cluster and returns a description of each problem
+// found, or nothing when the cluster is healthy
+func clusterProblems(cluster *cmv1.Cluster, limitedSupportReasons []*cmv1.LimitedSupportReason,
+	machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) []string {
+	problems := []string{}
+	if cluster.State() == cmv1.ClusterStateError || cluster.Status().State() == cmv1.ClusterStateError {
+		problems = append(problems, fmt.Sprintf("State: %s", cmv1.ClusterStateError))
+	}
+	if cluster.Status().ProvisionErrorCode() != "" || cluster.Status().ProvisionErrorMessage() != "" {
+		problems = append(problems, fmt.Sprintf("Provisioning error: %s %s",
+			cluster.Status().ProvisionErrorCode(), cluster.Status().ProvisionErrorMessage()))
+	}
+	for _, reason := range limitedSupportReasons {
+		problems = append(problems, fmt.Sprintf("Limited support: %s", reason.Summary()))
+	}
+	problems = append(problems, zoneImbalances(machinePools, nodePools)...)
+	for _, nodePool := range nodePools {
+		ready, desired := nodePoolReadiness(nodePool)
+		if ready < desired {
+			problems = append(problems, fmt.Sprintf("Node pool '%s' has %d/%d nodes ready",
+				nodePool.ID(), ready, desired))
+		}
+	}
+	return problems
+}
+
+// zoneImbalances describes the pools whose nodes can't be spread evenly across their availability
+// zones
+func zoneImbalances(machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) []string {
+	imbalances := []string{}
+	for _, machinePool := range machinePools {
+		zones := len(machinePool.AvailabilityZones())
+		if zones > 1 && machinePool.Autoscaling() == nil && machinePool.Replicas()%zones != 0 {
+			imbalances = append(imbalances, fmt.Sprintf(
+				"AZ imbalance: machine pool '%s' has %d replicas across %d availability zones",
+				machinePool.ID(), machinePool.Replicas(), zones))
+		}
+	}
+	if imbalance := nodePoolsZoneImbalance(nodePools); imbalance != "" {
+		imbalances = append(imbalances, imbalance)
+	}
+	return imbalances
+}
+
+// nodePoolsZoneImbalance warns when the node pools of a hosted control plane cluster spread
+// across several availability zones request a different number of nodes in each one
+func nodePoolsZoneImbalance(nodePools []*cmv1.NodePool) string {
+	replicas := map[string]int{}
+	for _, nodePool := range nodePools {
+		_, desired := nodePoolReadiness(nodePool)
+		replicas[nodePool.AvailabilityZone()] += desired
+	}
+	if len(replicas) < 2 {
+		return ""
+	}
+	zones := sortedKeys(replicas)
+	low, high := zones[0], zones[0]
+	for _, zone := range zones {
+		if replicas[zone] < replicas[low] {
+			low = zone
+		}
+		if replicas[zone] > replicas[high] {
+			high = zone
+		}
+	}
+	if replicas[high]-replicas[low] <= 1 {
+		return ""
+	}
+	return fmt.Sprintf("AZ imbalance: %d nodes in '%s' but %d nodes in '%s'",
+		replicas[high], high, replicas[low], low)
+}
+
+// clusterProblemsReport describes the problems of an unhealthy cluster
+func clusterProblemsReport(cluster *cmv1.Cluster, problems []string) string {
+	str := fmt.Sprintf("Cluster '%s' (%s):\n", cluster.Name(), cluster.ID())
+	for _, problem := range problems {
+		str += fmt.Sprintf(" - %s\n", problem)
+	}
+	return str
+}
+
+// Identifiers of the pools created with the cluster: the machine pool of classic clusters and the
+// node pools of hosted control plane clusters, one per availability zone
+const (
+	defaultMachinePoolID    = "worker"
+	defaultNodePoolIDPrefix = "workers"
+	noScheduleTaintEffect   = "NoSchedule"
+)
+
+func isDefaultNodePool(nodePool *cmv1.NodePool) bool {
+	return nodePool.ID() == defaultNodePoolIDPrefix || strings.HasPrefix(nodePool.ID(), defaultNodePoolIDPrefix+"-")
+}
+
+func hasNoScheduleTaint(taints []*cmv1.Taint) bool {
+	for _, taint := range taints {
+		if taint.Effect() == noScheduleTaintEffect {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultPoolsTaintsWarnings warns about the default pools with NoSchedule taints, that keep the
+// pods without a matching toleration pending when there is no other pool
+func defaultPoolsTaintsWarnings(machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) []clusterWarning {
+	warnings := []clusterWarning{}
+	for _, machinePool := range machinePools {
+		if machinePool.ID() == defaultMachinePoolID && hasNoScheduleTaint(machinePool.Taints()) {
+			warnings = append(warnings, clusterWarning{
+				code:    warningDefaultPoolTaints,
+				message: fmt.Sprintf("default pool '%s' has NoSchedule taints", machinePool.ID()),
+			})
+		}
+	}
+	for _, nodePool := range nodePools {
+		if isDefaultNodePool(nodePool) && hasNoScheduleTaint(nodePool.Taints()) {
+			warnings = append(warnings, clusterWarning{
+				code:    warningDefaultPoolTaints,
+				message: fmt.Sprintf("default pool '%s' has NoSchedule taints", nodePool.ID()),
+			})
+		}
+	}
+	return warnings
+}
+
+func defaultPoolsTaints(machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) string {
+	return warningLines(defaultPoolsTaintsWarnings(machinePools, nodePools))
+}
+
+// noSchedulablePoolWarnings warns when every pool has NoSchedule taints, as then the deployments
+// without tolerations stay pending forever
+func noSchedulablePoolWarnings(machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) []clusterWarning {
+	if len(machinePools) == 0 && len(nodePools) == 0 {
+		return []clusterWarning{}
+	}
+	for _, machinePool := range machinePools {
+		if !hasNoScheduleTaint(machinePool.Taints()) {
+			return []clusterWarning{}
+		}
+	}
+	for _, nodePool := range nodePools {
+		if !hasNoScheduleTaint(nodePool.Taints()) {
+			return []clusterWarning{}
+		}
+	}
+	return []clusterWarning{{
+		code: warningNoSchedulablePool,
+		message: "No schedulable default pool: every pool has NoSchedule taints, so the pods without a " +
+			"matching toleration stay pending",
+	}}
+}
+
+func noSchedulablePool(machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) string {
+	return warningLines(noSchedulablePoolWarnings(machinePools, nodePools))
+}
+
+// minorVersionSkew returns how many minor versions the node pool version is behind the control
+// plane version. Versions that can't be compared have no skew.
+func minorVersionSkew(controlPlaneVersion string, nodePoolVersion string) int {
+	controlPlane, err := semver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return 0
+	}
+	nodePool, err := semver.NewVersion(nodePoolVersion)
+	if err != nil {
+		return 0
+	}
+	if controlPlane.Segments()[0] != nodePool.Segments()[0] {
+		return 0
+	}
+	return controlPlane.Segments()[1] - nodePool.Segments()[1]
+}
+
+// nodePoolsVersionSkewWarnings warns about the node pools that lag behind the control plane by
+// more minor versions than supported, usually because they were forgotten during a staged upgrade
+func nodePoolsVersionSkewWarnings(cluster *cmv1.Cluster, nodePools []*cmv1.NodePool) []clusterWarning {
+	warnings := []clusterWarning{}
+	for _, nodePool := range nodePools {
+		nodePoolVersion := nodePool.Version().RawID()
+		if minorVersionSkew(cluster.OpenshiftVersion(), nodePoolVersion) > maxNodePoolVersionSkew {
+			warnings = append(warnings, clusterWarning{
+				code: warningNodePoolVersionSkew,
+				message: fmt.Sprintf("node pool '%s' version skew: %s is more than %d minor versions "+
+					"behind the control plane %s", nodePool.ID(), nodePoolVersion, maxNodePoolVersionSkew,
+					cluster.OpenshiftVersion()),
+			})
+		}
+	}
+	return warnings
+}
+
+func nodePoolsVersionSkew(cluster *cmv1.Cluster, nodePools []*cmv1.NodePool) string {
+	return warningLines(nodePoolsVersionSkewWarnings(cluster, nodePools))
+}
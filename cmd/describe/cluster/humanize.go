@@ -0,0 +1,30 @@
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+)
+
+// formatCount formats a node count. With '--humanize' the thousands are separated and the counts
+// of ten thousand or more are shortened, for example '1,500' and '12.5k'. Otherwise it's a plain
+// integer, which is what scripts parsing the output expect.
+func formatCount(n int) string {
+	if !args.humanize {
+		return strconv.Itoa(n)
+	}
+	switch {
+	case n >= 1_000_000:
+		return shortCount(float64(n)/1_000_000, "M")
+	case n >= 10_000:
+		return shortCount(float64(n)/1_000, "k")
+	default:
+		return humanize.Comma(int64(n))
+	}
+}
+
+func shortCount(value float64, suffix string) string {
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", value), ".0") + suffix
+}
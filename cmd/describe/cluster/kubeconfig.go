@@ -0,0 +1,90 @@
+package cluster
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// kubeconfig contains the parts of a kubeconfig file needed to find the API server of the current
+// context
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Contexts       []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server string `yaml:"server"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+}
+
+// kubeconfigPath returns the kubeconfig file that 'oc' and 'kubectl' use: the first one of the
+// 'KUBECONFIG' environment variable, or '~/.kube/config'
+func kubeconfigPath() (string, error) {
+	if paths := filepath.SplitList(os.Getenv("KUBECONFIG")); len(paths) > 0 && paths[0] != "" {
+		return paths[0], nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".kube", "config"), nil
+}
+
+// currentContextServer returns the URL of the API server of the current context of the kubeconfig
+func currentContextServer(data []byte) (string, error) {
+	config := kubeconfig{}
+	err := yaml.Unmarshal(data, &config)
+	if err != nil {
+		return "", err
+	}
+	if config.CurrentContext == "" {
+		return "", fmt.Errorf("there is no current context")
+	}
+	clusterName := ""
+	for _, context := range config.Contexts {
+		if context.Name == config.CurrentContext {
+			clusterName = context.Context.Cluster
+		}
+	}
+	if clusterName == "" {
+		return "", fmt.Errorf("the current context '%s' doesn't exist", config.CurrentContext)
+	}
+	for _, cluster := range config.Clusters {
+		if cluster.Name == clusterName && cluster.Cluster.Server != "" {
+			return strings.TrimSuffix(cluster.Cluster.Server, "/"), nil
+		}
+	}
+	return "", fmt.Errorf("the cluster '%s' of the current context doesn't have a server", clusterName)
+}
+
+// clusterIDFromKubeconfig resolves the cluster of the current context of the kubeconfig to its
+// OCM identifier, matching the URL of its API server
+func clusterIDFromKubeconfig(r *rosa.Runtime, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read kubeconfig '%s': %v", path, err)
+	}
+	server, err := currentContextServer(data)
+	if err != nil {
+		return "", fmt.Errorf("Failed to find the API server in kubeconfig '%s': %v", path, err)
+	}
+	r.Reporter.Debugf("Loading cluster with API URL '%s'", server)
+	cluster, err := r.OCMClient.GetClusterByAPIURL(server, r.Creator)
+	if err != nil {
+		return "", fmt.Errorf("Failed to find the cluster of the current context of kubeconfig '%s': %v%s",
+			path, err, requestContext(err))
+	}
+	return cluster.ID(), nil
+}
@@ -0,0 +1,46 @@
+package cluster
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+// apiLatency is the round-trip time to the API server of the cluster, or the reason why it
+// couldn't be measured
+type apiLatency struct {
+	duration time.Duration
+	err      error
+}
+
+// measureAPILatency measures the time it takes to open a TCP connection to the API server, which
+// is one round trip
+func measureAPILatency(apiURL string, timeout time.Duration) (time.Duration, error) {
+	parsed, err := url.Parse(apiURL)
+	if err != nil || parsed.Hostname() == "" {
+		return 0, fmt.Errorf("invalid API URL '%s'", apiURL)
+	}
+	port := parsed.Port()
+	if port == "" {
+		port = "443"
+	}
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(parsed.Hostname(), port), timeout)
+	if err != nil {
+		return 0, err
+	}
+	elapsed := time.Since(start)
+	conn.Close()
+	return elapsed, nil
+}
+
+func apiLatencyConfig(latency *apiLatency) string {
+	if latency == nil {
+		return ""
+	}
+	if latency.err != nil {
+		return "API Latency:                Unreachable\n"
+	}
+	return fmt.Sprintf("API Latency:                %dms\n", latency.duration.Milliseconds())
+}
@@ -0,0 +1,22 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// loginURL returns the page of the OAuth server of the cluster where the users of the identity
+// providers log in to get a token for 'oc login'
+func loginURL(cluster *cmv1.Cluster) (string, error) {
+	oauthURL, err := ocm.BuildOAuthURL(cluster, "")
+	if err != nil {
+		return "", err
+	}
+	if oauthURL == "" {
+		return "", nil
+	}
+	return fmt.Sprintf("%s/oauth/token/request", oauthURL), nil
+}
@@ -0,0 +1,20 @@
+package cluster
+
+import (
+	"strings"
+)
+
+// urlAliases replaces the host names that reveal the environment of the cluster. The console of
+// the stage environment comes first, as its host name contains the one of production.
+var urlAliases = strings.NewReplacer(
+	"qaprodauth.console.redhat.com", "<console-stage>",
+	"console.redhat.com", "<console-prod>",
+	"api.integration.openshift.com", "<ocm-integration>",
+	"api.stage.openshift.com", "<ocm-stage>",
+	"api.openshift.com", "<ocm-prod>",
+)
+
+// maskURLs replaces the known host names of the OCM API and of the console with their aliases
+func maskURLs(str string) string {
+	return urlAliases.Replace(str)
+}
@@ -0,0 +1,44 @@
+package cluster
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const defaultConcurrency = 4
+
+// describeClusters describes the clusters with the given keys using at most the given number of
+// concurrent workers. The descriptions are returned in the order of the keys, skipping the
+// clusters that failed or don't match the selector, and the errors of all the failed clusters
+// are joined.
+func describeClusters(r *rosa.Runtime, clusterKeys []string, concurrency int) ([]*clusterDescription, error) {
+	results := make([]*clusterDescription, len(clusterKeys))
+	errs := make([]error, len(clusterKeys))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < min(concurrency, len(clusterKeys)); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i], errs[i] = describeCluster(r, clusterKeys[i])
+			}
+		}()
+	}
+	for i := range clusterKeys {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	descriptions := []*clusterDescription{}
+	for _, result := range results {
+		if result != nil {
+			descriptions = append(descriptions, result)
+		}
+	}
+	return descriptions, errors.Join(errs...)
+}
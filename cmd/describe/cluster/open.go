@@ -0,0 +1,42 @@
+package cluster
+
+import (
+	"errors"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper/browser"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// clusterPageURL returns the details page of the cluster in the console of OCM, falling back to the
+// console of the cluster itself in environments without a details page
+func clusterPageURL(connectionURL string, cluster *cmv1.Cluster) string {
+	if detailsPage := getDetailsLink(connectionURL); detailsPage != "" && cluster.Subscription().ID() != "" {
+		return detailsPage + cluster.Subscription().ID()
+	}
+	return cluster.Console().URL()
+}
+
+// openClusterPage opens the page of the cluster in the default browser. Failing to open it isn't
+// an error, the URL is printed so it can be opened manually.
+func openClusterPage(r *rosa.Runtime, cluster *cmv1.Cluster) {
+	pageURL := clusterPageURL(r.OCMClient.GetConnectionURL(), cluster)
+	if pageURL == "" {
+		r.Reporter.Warnf("Cluster '%s' has no details page or console URL to open", cluster.Name())
+		return
+	}
+	err := browser.Open(pageURL)
+	if errors.Is(err, browser.ErrNoBrowser) {
+		r.Reporter.Warnf("No browser is available, open '%s' manually", pageURL)
+		return
+	}
+	if err != nil {
+		r.Reporter.Warnf("Failed to open '%s' in the browser: %v", pageURL, err)
+		return
+	}
+	if r.Reporter.IsTerminal() && !output.HasFlag() {
+		r.Reporter.Infof("Opened '%s' in the browser", pageURL)
+	}
+}
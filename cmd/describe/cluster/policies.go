@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// rolePoliciesVersion is the version of the policies attached to the account roles of an STS
+// cluster, and the latest version available for its channel group
+type rolePoliciesVersion struct {
+	current          string
+	latest           string
+	upgradeAvailable bool
+}
+
+// lookupRolePoliciesVersion compares the version of the policies of the account roles of the
+// cluster to the latest one, the same way as 'rosa upgrade roles'. It's only meaningful for
+// policies that aren't managed by AWS, as those are always kept up to date.
+func lookupRolePoliciesVersion(r *rosa.Runtime, cluster *cmv1.Cluster) (*rolePoliciesVersion, error) {
+	roleName, err := aws.GetResourceIdFromARN(cluster.AWS().STS().RoleARN())
+	if err != nil {
+		return nil, err
+	}
+	current, err := r.AWSClient.GetAccountRoleVersion(roleName)
+	if err != nil {
+		return nil, err
+	}
+	channelGroup := cluster.Version().ChannelGroup()
+	if channelGroup == "" {
+		channelGroup = ocm.DefaultChannelGroup
+	}
+	latest, err := r.OCMClient.GetPolicyVersion("", channelGroup)
+	if err != nil {
+		return nil, err
+	}
+	upgradeAvailable, err := r.AWSClient.IsUpgradedNeededForAccountRolePoliciesUsingCluster(cluster, latest)
+	if err != nil {
+		return nil, err
+	}
+	return &rolePoliciesVersion{
+		current:          current,
+		latest:           latest,
+		upgradeAvailable: upgradeAvailable,
+	}, nil
+}
+
+func rolePoliciesVersionConfig(version *rolePoliciesVersion) string {
+	if version == nil {
+		return ""
+	}
+	current := version.current
+	if current == "" {
+		current = "Unknown"
+	}
+	if version.upgradeAvailable {
+		return fmt.Sprintf("Policy Version:             %s (upgrade available: %s, run 'rosa upgrade roles')\n",
+			current, version.latest)
+	}
+	return fmt.Sprintf("Policy Version:             %s\n", current)
+}
+
+func formatRolePoliciesVersion(version *rolePoliciesVersion) map[string]interface{} {
+	return map[string]interface{}{
+		"current":          version.current,
+		"latest":           version.latest,
+		"upgradeAvailable": version.upgradeAvailable,
+	}
+}
@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const pollInterval = 30 * time.Second
+
+// pollCondition is a field of the cluster and the value that '--poll-until-field' waits for
+type pollCondition struct {
+	field clusterField
+	value string
+}
+
+// parsePollCondition parses conditions like 'state=ready', where the field is one of the fields
+// that can be explained
+func parsePollCondition(condition string) (pollCondition, error) {
+	name, value, found := strings.Cut(condition, "=")
+	if !found || name == "" {
+		return pollCondition{}, fmt.Errorf("Invalid condition '%s', it should be '<field>=<value>'", condition)
+	}
+	field, err := findClusterField(name)
+	if err != nil {
+		return pollCondition{}, err
+	}
+	return pollCondition{
+		field: field,
+		value: value,
+	}, nil
+}
+
+// jitteredInterval adds a random delay of up to the jitter to the interval, so that many processes
+// polling the same API don't send their requests at the same time
+func jitteredInterval(interval time.Duration, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return interval
+	}
+	return interval + time.Duration(rand.Int63n(int64(jitter)+1))
+}
+
+// matches checks if the given value of the field is the value of the condition. The comparison
+// ignores case, and 'true' and 'false' also match the 'Yes' and 'No' of the boolean fields.
+func (condition pollCondition) matches(value string) bool {
+	return strings.EqualFold(normalizeConditionValue(value), normalizeConditionValue(condition.value))
+}
+
+func normalizeConditionValue(value string) string {
+	switch strings.ToLower(value) {
+	case "true":
+		return "yes"
+	case "false":
+		return "no"
+	}
+	return value
+}
+
+// pollUntilField fetches the cluster every interval, plus up to the jitter, until the field has the
+// value of the condition, or fails when that doesn't happen before the timeout
+func pollUntilField(r *rosa.Runtime, clusterKey string, condition pollCondition,
+	timeout time.Duration, interval time.Duration, jitter time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return fmt.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
+		}
+		current := condition.field.value(cluster)
+		if condition.matches(current) {
+			return nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("Timed out after %s waiting for the %s of cluster '%s' to be '%s', it's '%s'",
+				timeout, condition.field.name, clusterKey, condition.value, current)
+		}
+		r.Reporter.Debugf("The %s of cluster '%s' is '%s', waiting for '%s'",
+			condition.field.name, clusterKey, current, condition.value)
+		time.Sleep(jitteredInterval(interval, jitter))
+	}
+}
+
+func pollUntilFieldCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	completions := []string{}
+	for _, name := range clusterFieldNames() {
+		completions = append(completions, name+"=")
+	}
+	return completions, cobra.ShellCompDirectiveNoSpace
+}
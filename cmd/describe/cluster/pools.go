@@ -0,0 +1,579 @@
+package cluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	semver "github.com/hashicorp/go-version"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	ocmOutput "github.com/openshift/rosa/pkg/ocm/output"
+	"github.com/openshift/rosa/pkg/output"
+)
+
+const (
+	machinePoolsSummary = "summary"
+	machinePoolsWide    = "wide"
+)
+
+var machinePoolsOptions = []string{machinePoolsSummary, machinePoolsWide}
+
+// poolColumn is a column of the machine pools table. Columns that don't apply to classic or
+// hosted control plane clusters leave the corresponding function unset. Columns of settings that
+// every pool inherits from the cluster set the cluster function instead.
+type poolColumn struct {
+	header      string
+	wide        bool
+	machinePool func(*cmv1.MachinePool) string
+	nodePool    func(*cmv1.NodePool) string
+	cluster     func(*cmv1.Cluster) string
+}
+
+var poolColumns = []poolColumn{
+	{
+		header: "ID",
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return machinePool.ID()
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return nodePool.ID()
+		},
+	},
+	{
+		header: "AUTOSCALING",
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return ocmOutput.PrintMachinePoolAutoscaling(machinePool.Autoscaling())
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolAutoscaling(nodePool.Autoscaling())
+		},
+	},
+	{
+		header: "REPLICAS",
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return ocmOutput.PrintMachinePoolReplicas(machinePool.Autoscaling(), machinePool.Replicas())
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolReplicasShort(
+				ocmOutput.PrintNodePoolCurrentReplicas(nodePool.Status()),
+				ocmOutput.PrintNodePoolReplicasInline(nodePool.Autoscaling(), nodePool.Replicas()),
+			)
+		},
+	},
+	{
+		header: "INSTANCE TYPE",
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return machinePool.InstanceType()
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolInstanceType(nodePool.AWSNodePool())
+		},
+	},
+	{
+		header: "AVAILABILITY ZONES",
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return output.PrintStringSlice(machinePool.AvailabilityZones())
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return nodePool.AvailabilityZone()
+		},
+	},
+	{
+		header: "VERSION",
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolVersion(nodePool.Version())
+		},
+	},
+	{
+		header: "LABELS",
+		wide:   true,
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return ocmOutput.PrintLabels(machinePool.Labels())
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintLabels(nodePool.Labels())
+		},
+	},
+	{
+		header: "TAINTS",
+		wide:   true,
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return ocmOutput.PrintTaints(machinePool.Taints())
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintTaints(nodePool.Taints())
+		},
+	},
+	{
+		header: "SUBNETS",
+		wide:   true,
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return output.PrintStringSlice(machinePool.Subnets())
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return nodePool.Subnet()
+		},
+	},
+	{
+		header: "TUNING CONFIGS",
+		wide:   true,
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolConfigs(nodePool.TuningConfigs())
+		},
+	},
+	{
+		header: "KUBELET CONFIGS",
+		wide:   true,
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolConfigs(nodePool.KubeletConfigs())
+		},
+	},
+	{
+		header: "SG IDs",
+		wide:   true,
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return output.PrintStringSlice(machinePool.AWS().AdditionalSecurityGroupIds())
+		},
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolAdditionalSecurityGroups(nodePool.AWSNodePool())
+		},
+	},
+	{
+		header: "SPOT INSTANCES",
+		wide:   true,
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return ocmOutput.PrintMachinePoolSpot(machinePool)
+		},
+	},
+	{
+		header: "DISK SIZE",
+		wide:   true,
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			return ocmOutput.PrintMachinePoolDiskSize(machinePool)
+		},
+	},
+	{
+		header: "ROOT VOLUME IOPS",
+		wide:   true,
+		machinePool: func(machinePool *cmv1.MachinePool) string {
+			if iops, ok := machinePoolRootVolumeIOPS(machinePool); ok {
+				return fmt.Sprintf("%d", iops)
+			}
+			return "default"
+		},
+	},
+	{
+		header: "ROOT VOLUME KMS KEY",
+		wide:   true,
+		cluster: func(cluster *cmv1.Cluster) string {
+			if cluster.AWS().KMSKeyArn() == "" {
+				return "AWS managed"
+			}
+			return cluster.AWS().KMSKeyArn()
+		},
+	},
+	{
+		header: "NODE DRAIN GRACE PERIOD",
+		wide:   true,
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodeDrainGracePeriod(nodePool.NodeDrainGracePeriod())
+		},
+	},
+	{
+		header: "AUTOREPAIR",
+		wide:   true,
+		nodePool: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolAutorepair(nodePool.AutoRepair())
+		},
+	},
+}
+
+// machinePoolsTable renders the machine pools, or the node pools of hosted control plane
+// clusters, as a table. The wide variant adds every available column.
+func machinePoolsTable(cluster *cmv1.Cluster, machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool,
+	wide bool) string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	isHypershift := cluster.Hypershift().Enabled()
+
+	columns := []poolColumn{}
+	for _, column := range poolColumns {
+		if column.wide && !wide {
+			continue
+		}
+		if column.cluster == nil &&
+			((isHypershift && column.nodePool == nil) || (!isHypershift && column.machinePool == nil)) {
+			continue
+		}
+		columns = append(columns, column)
+	}
+
+	for _, column := range columns {
+		fmt.Fprintf(writer, "%s\t", column.header)
+	}
+	fmt.Fprint(writer, "\n")
+	for _, machinePool := range machinePools {
+		for _, column := range columns {
+			if column.cluster != nil {
+				fmt.Fprintf(writer, "%s\t", column.cluster(cluster))
+			} else {
+				fmt.Fprintf(writer, "%s\t", column.machinePool(machinePool))
+			}
+		}
+		fmt.Fprint(writer, "\n")
+	}
+	for _, nodePool := range nodePools {
+		for _, column := range columns {
+			if column.cluster != nil {
+				fmt.Fprintf(writer, "%s\t", column.cluster(cluster))
+			} else {
+				fmt.Fprintf(writer, "%s\t", column.nodePool(nodePool))
+			}
+		}
+		fmt.Fprint(writer, "\n")
+	}
+	writer.Flush()
+
+	return b.String()
+}
+
+// nodePoolsTree renders the cluster with its node pools as children, each one followed by its
+// main attributes
+func nodePoolsTree(cluster *cmv1.Cluster, nodePools []*cmv1.NodePool) string {
+	str := fmt.Sprintf("%s (%s)\n", cluster.Name(), cluster.ID())
+	for i, nodePool := range nodePools {
+		branch, indent := "├── ", "│   "
+		if i == len(nodePools)-1 {
+			branch, indent = "└── ", "    "
+		}
+		str += branch + nodePool.ID() + "\n"
+		attributes := [][2]string{
+			{"Version", ocmOutput.PrintNodePoolVersion(nodePool.Version())},
+			{"Instance Type", ocmOutput.PrintNodePoolInstanceType(nodePool.AWSNodePool())},
+			{"Replicas", ocmOutput.PrintNodePoolReplicasShort(
+				ocmOutput.PrintNodePoolCurrentReplicas(nodePool.Status()),
+				ocmOutput.PrintNodePoolReplicasInline(nodePool.Autoscaling(), nodePool.Replicas()),
+			)},
+			{"Autoscaling", ocmOutput.PrintNodePoolAutoscaling(nodePool.Autoscaling())},
+			{"Availability Zone", nodePool.AvailabilityZone()},
+			{"Subnet", nodePool.Subnet()},
+		}
+		for j, attribute := range attributes {
+			attributeBranch := "├── "
+			if j == len(attributes)-1 {
+				attributeBranch = "└── "
+			}
+			str += fmt.Sprintf("%s%s%s: %s\n", indent, attributeBranch, attribute[0], attribute[1])
+		}
+	}
+	return str
+}
+
+// formatNodePools returns the JSON representation of the node pools, to nest them under the
+// cluster
+func formatNodePools(nodePools []*cmv1.NodePool) ([]interface{}, error) {
+	var b bytes.Buffer
+	err := cmv1.MarshalNodePoolList(nodePools, &b)
+	if err != nil {
+		return nil, err
+	}
+	ret := []interface{}{}
+	err = json.Unmarshal(b.Bytes(), &ret)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// nodePoolReadiness returns the number of ready nodes of the node pool and the minimum number of
+// nodes that it should have
+func nodePoolReadiness(nodePool *cmv1.NodePool) (ready int, desired int) {
+	desired = nodePool.Replicas()
+	if nodePool.Autoscaling() != nil {
+		desired = nodePool.Autoscaling().MinReplica()
+	}
+	return nodePool.Status().CurrentReplicas(), desired
+}
+
+// nodePoolsReadiness describes the number of ready nodes of each node pool, highlighting the pools
+// that have fewer nodes than desired
+func nodePoolsReadiness(nodePools []*cmv1.NodePool) string {
+	if len(nodePools) == 0 {
+		return ""
+	}
+	str := "Node Pool Readiness:\n"
+	for _, nodePool := range nodePools {
+		ready, desired := nodePoolReadiness(nodePool)
+		line := fmt.Sprintf("%d/%d ready", ready, desired)
+		if ready < desired {
+			line += fmt.Sprintf(" (WARNING: %d not ready", desired-ready)
+			if nodePool.Status().Message() != "" {
+				line += ": " + nodePool.Status().Message()
+			}
+			line += ")"
+		}
+		str += fmt.Sprintf(" - %-24s%s\n", nodePool.ID()+":", line)
+	}
+	return str
+}
+
+func formatNodePoolsReadiness(nodePools []*cmv1.NodePool) map[string]interface{} {
+	readiness := map[string]interface{}{}
+	for _, nodePool := range nodePools {
+		ready, desired := nodePoolReadiness(nodePool)
+		readiness[nodePool.ID()] = map[string]int{
+			"ready":   ready,
+			"desired": desired,
+		}
+	}
+	return readiness
+}
+
+// machinePoolRootVolumeIOPS returns the IOPS of the root volume of the machine pool, when it was
+// set explicitly
+func machinePoolRootVolumeIOPS(machinePool *cmv1.MachinePool) (int, bool) {
+	return machinePool.RootVolume().AWS().GetIOPS()
+}
+
+func formatRootVolumeIOPS(machinePools []*cmv1.MachinePool) map[string]int {
+	iops := map[string]int{}
+	for _, machinePool := range machinePools {
+		if value, ok := machinePoolRootVolumeIOPS(machinePool); ok {
+			iops[machinePool.ID()] = value
+		}
+	}
+	return iops
+}
+
+func formatNodeDrainGracePeriods(nodePools []*cmv1.NodePool) map[string]interface{} {
+	periods := map[string]interface{}{}
+	for _, nodePool := range nodePools {
+		period := nodePool.NodeDrainGracePeriod()
+		if period == nil || period.Value() == 0 {
+			continue
+		}
+		periods[nodePool.ID()] = map[string]interface{}{
+			"value": period.Value(),
+			"unit":  period.Unit(),
+		}
+	}
+	return periods
+}
+
+// nodePoolsZones returns the availability zones where the node pools run, sorted
+func nodePoolsZones(nodePools []*cmv1.NodePool) []string {
+	zones := map[string]struct{}{}
+	for _, nodePool := range nodePools {
+		zones[nodePool.AvailabilityZone()] = struct{}{}
+	}
+	return sortedKeys(zones)
+}
+
+// poolAttribute is an attribute of the node pools compared by '--diff-pools'
+type poolAttribute struct {
+	header string
+	key    string
+	value  func(*cmv1.NodePool) string
+}
+
+var diffPoolAttributes = []poolAttribute{
+	{
+		header: "INSTANCE TYPE",
+		key:    "instanceType",
+		value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolInstanceType(nodePool.AWSNodePool())
+		},
+	},
+	{
+		header: "VERSION",
+		key:    "version",
+		value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintNodePoolVersion(nodePool.Version())
+		},
+	},
+	{
+		header: "LABELS",
+		key:    "labels",
+		value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintLabels(nodePool.Labels())
+		},
+	},
+	{
+		header: "TAINTS",
+		key:    "taints",
+		value: func(nodePool *cmv1.NodePool) string {
+			return ocmOutput.PrintTaints(nodePool.Taints())
+		},
+	},
+}
+
+func findNodePool(nodePools []*cmv1.NodePool, id string) (*cmv1.NodePool, error) {
+	for _, nodePool := range nodePools {
+		if nodePool.ID() == id {
+			return nodePool, nil
+		}
+	}
+	ids := []string{}
+	for _, nodePool := range nodePools {
+		ids = append(ids, nodePool.ID())
+	}
+	return nil, fmt.Errorf("There is no node pool '%s'. Node pools are %s", id, ids)
+}
+
+// nodePoolsDifferences returns the attributes of each node pool that differ from the baseline pool,
+// keyed by pool. Pools identical to the baseline are omitted.
+func nodePoolsDifferences(baseline *cmv1.NodePool, nodePools []*cmv1.NodePool) map[string]map[string]string {
+	differences := map[string]map[string]string{}
+	for _, nodePool := range nodePools {
+		if nodePool.ID() == baseline.ID() {
+			continue
+		}
+		for _, attribute := range diffPoolAttributes {
+			value := attribute.value(nodePool)
+			if value == attribute.value(baseline) {
+				continue
+			}
+			if differences[nodePool.ID()] == nil {
+				differences[nodePool.ID()] = map[string]string{}
+			}
+			differences[nodePool.ID()][attribute.key] = value
+		}
+	}
+	return differences
+}
+
+// nodePoolsDiffTable renders the node pools as a matrix against the baseline pool: the values that
+// are the same as the baseline are shown as '=', and the different ones are marked with '*'
+func nodePoolsDiffTable(baseline *cmv1.NodePool, nodePools []*cmv1.NodePool) string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprint(writer, "ID\t")
+	for _, attribute := range diffPoolAttributes {
+		fmt.Fprintf(writer, "%s\t", attribute.header)
+	}
+	fmt.Fprint(writer, "\n")
+	fmt.Fprintf(writer, "%s (baseline)\t", baseline.ID())
+	for _, attribute := range diffPoolAttributes {
+		fmt.Fprintf(writer, "%s\t", attribute.value(baseline))
+	}
+	fmt.Fprint(writer, "\n")
+	for _, nodePool := range nodePools {
+		if nodePool.ID() == baseline.ID() {
+			continue
+		}
+		fmt.Fprintf(writer, "%s\t", nodePool.ID())
+		for _, attribute := range diffPoolAttributes {
+			value := attribute.value(nodePool)
+			if value == attribute.value(baseline) {
+				value = "="
+			} else {
+				value = "*" + value
+			}
+			fmt.Fprintf(writer, "%s\t", value)
+		}
+		fmt.Fprint(writer, "\n")
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// Upgrade settings that node pools get when they don't set their own
+const (
+	defaultUpgradeMaxSurge       = "1"
+	defaultUpgradeMaxUnavailable = "0"
+)
+
+// poolUpgradeSettings is how many nodes a node pool can add and take down at a time during upgrades
+type poolUpgradeSettings struct {
+	maxSurge       string
+	maxUnavailable string
+}
+
+// nodePoolsUpgradeSettings returns the upgrade settings of the node pools that don't use the
+// defaults, keyed by pool
+func nodePoolsUpgradeSettings(nodePools []*cmv1.NodePool) map[string]poolUpgradeSettings {
+	settings := map[string]poolUpgradeSettings{}
+	for _, nodePool := range nodePools {
+		upgrade := nodePool.ManagementUpgrade()
+		poolSettings := poolUpgradeSettings{
+			maxSurge:       defaultUpgradeMaxSurge,
+			maxUnavailable: defaultUpgradeMaxUnavailable,
+		}
+		if upgrade.MaxSurge() != "" {
+			poolSettings.maxSurge = upgrade.MaxSurge()
+		}
+		if upgrade.MaxUnavailable() != "" {
+			poolSettings.maxUnavailable = upgrade.MaxUnavailable()
+		}
+		if poolSettings.maxSurge != defaultUpgradeMaxSurge || poolSettings.maxUnavailable != defaultUpgradeMaxUnavailable {
+			settings[nodePool.ID()] = poolSettings
+		}
+	}
+	return settings
+}
+
+func nodePoolsUpgradeDescription(settings map[string]poolUpgradeSettings) string {
+	if len(settings) == 0 {
+		return ""
+	}
+	str := "Node Pool Upgrades:\n"
+	for _, poolID := range sortedKeys(settings) {
+		str += fmt.Sprintf(" - %-24sMax Surge: %s, Max Unavailable: %s\n", poolID+":",
+			settings[poolID].maxSurge, settings[poolID].maxUnavailable)
+	}
+	return str
+}
+
+func formatNodePoolsUpgradeSettings(settings map[string]poolUpgradeSettings) map[string]interface{} {
+	f := map[string]interface{}{}
+	for poolID, poolSettings := range settings {
+		f[poolID] = map[string]string{
+			"maxSurge":       poolSettings.maxSurge,
+			"maxUnavailable": poolSettings.maxUnavailable,
+		}
+	}
+	return f
+}
+
+// nodePoolsByVersion counts the node pools of each version, to follow the progress of the staged
+// upgrades of the node pools
+func nodePoolsByVersion(nodePools []*cmv1.NodePool) map[string]int {
+	counts := map[string]int{}
+	for _, nodePool := range nodePools {
+		counts[nodePool.Version().RawID()]++
+	}
+	return counts
+}
+
+// sortedPoolVersions returns the versions newest first. Versions that can't be parsed go last.
+func sortedPoolVersions(counts map[string]int) []string {
+	versions := sortedKeys(counts)
+	sort.SliceStable(versions, func(i, j int) bool {
+		a, errA := semver.NewVersion(versions[i])
+		b, errB := semver.NewVersion(versions[j])
+		if errA != nil || errB != nil {
+			return errA == nil && errB != nil
+		}
+		return a.GreaterThan(b)
+	})
+	return versions
+}
+
+func nodePoolVersionsDescription(counts map[string]int) string {
+	if len(counts) == 0 {
+		return ""
+	}
+	groups := []string{}
+	for _, version := range sortedPoolVersions(counts) {
+		unit := "pools"
+		if counts[version] == 1 {
+			unit = "pool"
+		}
+		groups = append(groups, fmt.Sprintf("%s: %d %s", version, counts[version], unit))
+	}
+	return fmt.Sprintf("Node Pool Versions:         %s\n", strings.Join(groups, ", "))
+}
@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const scalingActivityWindow = time.Hour
+
+// Matches the service log entries about the autoscaler adding or removing nodes, capturing the
+// direction
+var scalingEventRE = regexp.MustCompile(`(?i)scal(?:e|ed|ing)[ -]?(up|down)`)
+
+// scalingEvent is a scale up or scale down of the nodes of the cluster
+type scalingEvent struct {
+	timestamp time.Time
+	direction string
+	summary   string
+}
+
+// isAutoscaled returns true when the default compute nodes or any of the pools are autoscaled
+func isAutoscaled(cluster *cmv1.Cluster, machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool) bool {
+	if cluster.Nodes().AutoscaleCompute() != nil {
+		return true
+	}
+	for _, machinePool := range machinePools {
+		if machinePool.Autoscaling() != nil {
+			return true
+		}
+	}
+	for _, nodePool := range nodePools {
+		if nodePool.Autoscaling() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupScalingActivity returns the scale ups and scale downs that the service logs of the
+// cluster recorded in the last hour, the newest first
+func lookupScalingActivity(r *rosa.Runtime, cluster *cmv1.Cluster, now time.Time) ([]scalingEvent, error) {
+	entries, err := r.OCMClient.GetClusterServiceLogs(cluster.ID(), now.Add(-scalingActivityWindow))
+	if err != nil {
+		return nil, err
+	}
+	return scalingEvents(entries), nil
+}
+
+func scalingEvents(entries []*slv1.LogEntry) []scalingEvent {
+	events := []scalingEvent{}
+	for _, entry := range entries {
+		match := scalingEventRE.FindStringSubmatch(entry.Summary() + " " + entry.Description())
+		if match == nil {
+			continue
+		}
+		events = append(events, scalingEvent{
+			timestamp: entry.Timestamp(),
+			direction: strings.ToLower(match[1]),
+			summary:   entry.Summary(),
+		})
+	}
+	return events
+}
+
+// scalingActivityDescription lists the recent scaling events with the totals per direction, so
+// that an autoscaler that keeps adding and removing nodes stands out
+func scalingActivityDescription(events []scalingEvent) string {
+	if len(events) == 0 {
+		return ""
+	}
+	ups := 0
+	for _, event := range events {
+		if event.direction == "up" {
+			ups++
+		}
+	}
+	str := fmt.Sprintf("Scaling Activity (last hour): %d up, %d down\n", ups, len(events)-ups)
+	for _, event := range events {
+		str += fmt.Sprintf(" - %s %-4s  %s\n",
+			event.timestamp.UTC().Format(time.TimeOnly), event.direction, event.summary)
+	}
+	return str
+}
+
+func formatScalingActivity(events []scalingEvent) []map[string]string {
+	list := []map[string]string{}
+	for _, event := range events {
+		list = append(list, map[string]string{
+			"timestamp": event.timestamp.UTC().Format(time.RFC3339),
+			"direction": event.direction,
+			"summary":   event.summary,
+		})
+	}
+	return list
+}
@@ -0,0 +1,31 @@
+package cluster
+
+import (
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// parseSelector parses selectors like 'region=us-east-1,multi-az=true', where every condition
+// uses one of the fields that can be explained
+func parseSelector(selector string) ([]pollCondition, error) {
+	conditions := []pollCondition{}
+	for _, predicate := range strings.Split(selector, ",") {
+		condition, err := parsePollCondition(strings.TrimSpace(predicate))
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+// matchesSelector checks if the cluster matches all the conditions of the selector
+func matchesSelector(cluster *cmv1.Cluster, conditions []pollCondition) bool {
+	for _, condition := range conditions {
+		if !condition.matches(condition.field.value(cluster)) {
+			return false
+		}
+	}
+	return true
+}
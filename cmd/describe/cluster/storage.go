@@ -0,0 +1,42 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// defaultStorageClassName is the storage class of the AWS EBS CSI driver that ROSA makes the default
+// one when the cluster is installed. OCM doesn't keep the storage classes of the cluster, so the
+// description can only assume that it's still the default.
+const defaultStorageClassName = "gp3-csi"
+
+// defaultStorageClassKMSKey returns the customer managed KMS key that the default storage class is
+// installed with. It's inferred from the KMS key of the cluster, that the EBS CSI driver also uses,
+// and it's empty when there is none.
+func defaultStorageClassKMSKey(cluster *cmv1.Cluster) string {
+	return cluster.AWS().KMSKeyArn()
+}
+
+// defaultStorageClassConfig says that the storage class and its key are inferred, as they aren't
+// read from the cluster
+func defaultStorageClassConfig(cluster *cmv1.Cluster) string {
+	if defaultStorageClassKMSKey(cluster) == "" {
+		return ""
+	}
+	return fmt.Sprintf("Default StorageClass:       %s (inferred: the ROSA default, with the KMS key of the "+
+		"cluster)\n", defaultStorageClassName)
+}
+
+func formatDefaultStorageClass(cluster *cmv1.Cluster) map[string]interface{} {
+	kmsKey := defaultStorageClassKMSKey(cluster)
+	if kmsKey == "" {
+		return nil
+	}
+	return map[string]interface{}{
+		"name":         defaultStorageClassName,
+		"kmsKeyArn":    kmsKey,
+		"kmsKeySource": "cluster",
+		"inferred":     true,
+	}
+}
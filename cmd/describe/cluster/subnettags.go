@@ -0,0 +1,101 @@
+package cluster
+
+import (
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+// Tags that the load balancer controllers use to find the subnets where they can create public and
+// internal load balancers
+const (
+	elbRoleTag         = "kubernetes.io/role/elb"
+	internalELBRoleTag = "kubernetes.io/role/internal-elb"
+)
+
+// subnetTagCheck is the result of checking that a subnet has the role tag that its load balancers
+// need
+type subnetTagCheck struct {
+	subnetID    string
+	public      bool
+	requiredTag string
+	tagged      bool
+}
+
+// verifySubnetTags checks that the public subnets of the cluster have the tag for public load
+// balancers, and the private ones the tag for internal load balancers
+func verifySubnetTags(awsClient aws.Client, subnetIDs []string) ([]subnetTagCheck, error) {
+	subnets, err := lookupSubnets(awsClient, subnetIDs)
+	if err != nil {
+		return nil, err
+	}
+	publicSubnets, err := awsClient.FetchPublicSubnetMap(subnets)
+	if err != nil {
+		return nil, err
+	}
+	checks := []subnetTagCheck{}
+	for _, subnet := range subnets {
+		subnetID := awssdk.ToString(subnet.SubnetId)
+		check := subnetTagCheck{
+			subnetID:    subnetID,
+			public:      publicSubnets[subnetID],
+			requiredTag: internalELBRoleTag,
+		}
+		if check.public {
+			check.requiredTag = elbRoleTag
+		}
+		check.tagged = hasSubnetTag(subnet.Tags, check.requiredTag)
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+func hasSubnetTag(tags []ec2types.Tag, key string) bool {
+	for _, tag := range tags {
+		if awssdk.ToString(tag.Key) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (check subnetTagCheck) visibility() string {
+	if check.public {
+		return "public"
+	}
+	return "private"
+}
+
+func (check subnetTagCheck) status() string {
+	if check.tagged {
+		return "OK"
+	}
+	return fmt.Sprintf("MISSING TAG %s", check.requiredTag)
+}
+
+func subnetTagsDescription(checks []subnetTagCheck) string {
+	if len(checks) == 0 {
+		return ""
+	}
+	str := "Subnet Tags:\n"
+	for _, check := range checks {
+		str += fmt.Sprintf(" - %s (%s): %s\n", check.subnetID, check.visibility(), check.status())
+	}
+	return str
+}
+
+func formatSubnetTags(checks []subnetTagCheck) []map[string]interface{} {
+	formatted := []map[string]interface{}{}
+	for _, check := range checks {
+		formatted = append(formatted, map[string]interface{}{
+			"subnetId":    check.subnetID,
+			"public":      check.public,
+			"requiredTag": check.requiredTag,
+			"status":      check.status(),
+		})
+	}
+	return formatted
+}
@@ -0,0 +1,17 @@
+package cluster
+
+import (
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// lookupManagementCluster returns the name of the management cluster that hosts the control plane
+// of a Hosted Control Plane cluster. OCM only returns it to SREs, so it fails for regular users.
+func lookupManagementCluster(r *rosa.Runtime, cluster *cmv1.Cluster) (string, error) {
+	hypershiftConfig, err := r.OCMClient.GetClusterHypershiftConfig(cluster.ID())
+	if err != nil {
+		return "", err
+	}
+	return hypershiftConfig.ManagementCluster(), nil
+}
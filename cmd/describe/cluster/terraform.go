@@ -0,0 +1,107 @@
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+// terraformLocal is a local of the Terraform export and the dotted key of the description of the
+// cluster that it's taken from
+type terraformLocal struct {
+	name string
+	key  string
+}
+
+var terraformLocals = []terraformLocal{
+	{name: "cluster_name", key: "name"},
+	{name: "region", key: "region.id"},
+	{name: "openshift_version", key: "openshift_version"},
+	{name: "channel_group", key: "version.channel_group"},
+	{name: "hosted_control_plane", key: "hypershift.enabled"},
+	{name: "multi_az", key: "multi_az"},
+	{name: "private_link", key: "aws.private_link"},
+	{name: "compute_machine_type", key: "nodes.compute_machine_type.id"},
+	{name: "replicas", key: "nodes.compute"},
+	{name: "machine_cidr", key: "network.machine_cidr"},
+	{name: "service_cidr", key: "network.service_cidr"},
+	{name: "pod_cidr", key: "network.pod_cidr"},
+	{name: "host_prefix", key: "network.host_prefix"},
+	{name: "aws_subnet_ids", key: "aws.subnet_ids"},
+	{name: "installer_role_arn", key: "aws.sts.role_arn"},
+	{name: "support_role_arn", key: "aws.sts.support_role_arn"},
+	{name: "controlplane_role_arn", key: "aws.sts.instance_iam_roles.master_role_arn"},
+	{name: "worker_role_arn", key: "aws.sts.instance_iam_roles.worker_role_arn"},
+	{name: "operator_role_prefix", key: "aws.sts.operator_role_prefix"},
+	{name: "oidc_config_id", key: "aws.sts.oidc_config.id"},
+}
+
+// terraformExport renders the key attributes of the cluster as Terraform locals. The values are
+// taken from the flattened description of the cluster, and the attributes that the cluster doesn't
+// have are left out.
+func terraformExport(cluster *cmv1.Cluster) (string, error) {
+	f, err := formatCluster(cluster, nil, nil, "")
+	if err != nil {
+		return "", err
+	}
+	flattened, err := output.Flatten(f)
+	if err != nil {
+		return "", err
+	}
+	flat := flattened.(map[string]interface{})
+
+	names := []string{}
+	values := map[string]string{}
+	width := 0
+	for _, local := range terraformLocals {
+		value, ok := terraformValue(flat, local.key)
+		if !ok {
+			continue
+		}
+		names = append(names, local.name)
+		values[local.name] = value
+		width = max(width, len(local.name))
+	}
+
+	str := fmt.Sprintf("# Starting point for the Terraform configuration of cluster '%s'. It isn't import\n"+
+		"# state: review the values, as settings that the cluster doesn't keep are missing.\n"+
+		"locals {\n", cluster.Name())
+	for _, name := range names {
+		str += fmt.Sprintf("  %-*s = %s\n", width, name, values[name])
+	}
+	str += "}\n"
+	return str, nil
+}
+
+// terraformValue returns the HCL value of the given key of the flattened description. Arrays were
+// flattened into one key per element, so they are put back together.
+func terraformValue(flat map[string]interface{}, key string) (string, bool) {
+	if value, ok := flat[key]; ok {
+		if _, isSlice := value.([]interface{}); !isSlice {
+			return terraformLiteral(value), true
+		}
+	}
+	elements := []string{}
+	for i := 0; ; i++ {
+		value, ok := flat[fmt.Sprintf("%s.%d", key, i)]
+		if !ok {
+			break
+		}
+		elements = append(elements, terraformLiteral(value))
+	}
+	if len(elements) == 0 {
+		return "", false
+	}
+	return fmt.Sprintf("[%s]", strings.Join(elements, ", ")), true
+}
+
+func terraformLiteral(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return strconv.Quote(str)
+	}
+	return fmt.Sprintf("%v", value)
+}
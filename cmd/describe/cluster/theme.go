@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	"regexp"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/color"
+)
+
+// Matches the lines of the description that introduce a section, like 'Machine Pools:'
+var headerLineRE = regexp.MustCompile(`^[A-Z][A-Za-z0-9 ()/-]*:$`)
+
+// Matches the 'State:' line, separating the state from the label and the phase
+var stateLineRE = regexp.MustCompile(`^(State:\s+)(\S+)(.*)$`)
+
+// colorizeDescription highlights the section headers, the state and the warnings of the
+// description with the colors of the theme
+func colorizeDescription(str string, theme color.Theme) string {
+	lines := strings.Split(str, "\n")
+	for i, line := range lines {
+		switch {
+		case headerLineRE.MatchString(line):
+			lines[i] = color.Paint(theme.Header, line)
+		case strings.HasPrefix(line, "⚠") || strings.HasPrefix(strings.TrimSpace(line), "NOTE:"):
+			lines[i] = color.Paint(theme.Warning, line)
+		default:
+			if match := stateLineRE.FindStringSubmatch(line); match != nil {
+				lines[i] = match[1] + color.Paint(stateColor(theme, cmv1.ClusterState(match[2])), match[2]) + match[3]
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func stateColor(theme color.Theme, state cmv1.ClusterState) string {
+	switch state {
+	case cmv1.ClusterStateReady:
+		return theme.Success
+	case cmv1.ClusterStateError, cmv1.ClusterStateUninstalling:
+		return theme.Failure
+	default:
+		return theme.Progress
+	}
+}
+
+func colorThemeCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return color.ThemeNames(), cobra.ShellCompDirectiveDefault
+}
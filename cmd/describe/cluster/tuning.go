@@ -0,0 +1,147 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// poolTuning contains the customized PID limit and sysctls of the nodes of a pool
+type poolTuning struct {
+	podPidsLimit int
+	sysctls      []string
+}
+
+// lookupPoolsTuning returns the tuning of the pools that have customized PID limits or sysctls,
+// keyed by pool. The kubelet config of classic clusters applies to every machine pool, hosted
+// control plane node pools reference their kubelet and tuning configs by name.
+func lookupPoolsTuning(r *rosa.Runtime, cluster *cmv1.Cluster, machinePools []*cmv1.MachinePool,
+	nodePools []*cmv1.NodePool) (map[string]poolTuning, error) {
+	tuning := map[string]poolTuning{}
+	if len(machinePools) > 0 {
+		kubeletConfig, exists, err := r.OCMClient.GetClusterKubeletConfig(cluster.ID())
+		if err != nil {
+			return nil, err
+		}
+		if exists && kubeletConfig.PodPidsLimit() != 0 {
+			for _, machinePool := range machinePools {
+				tuning[machinePool.ID()] = poolTuning{podPidsLimit: kubeletConfig.PodPidsLimit()}
+			}
+		}
+	}
+
+	referenced := false
+	for _, nodePool := range nodePools {
+		referenced = referenced || len(nodePool.KubeletConfigs()) > 0 || len(nodePool.TuningConfigs()) > 0
+	}
+	if !referenced {
+		return tuning, nil
+	}
+	kubeletConfigs, err := r.OCMClient.ListKubeletConfigs(context.Background(), cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	tuningConfigs, err := r.OCMClient.GetTuningConfigs(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	for _, nodePool := range nodePools {
+		poolTuning := nodePoolTuning(nodePool, kubeletConfigs, tuningConfigs)
+		if poolTuning.podPidsLimit != 0 || len(poolTuning.sysctls) > 0 {
+			tuning[nodePool.ID()] = poolTuning
+		}
+	}
+	return tuning, nil
+}
+
+func nodePoolTuning(nodePool *cmv1.NodePool, kubeletConfigs []*cmv1.KubeletConfig,
+	tuningConfigs []*cmv1.TuningConfig) poolTuning {
+	tuning := poolTuning{}
+	for _, kubeletConfig := range kubeletConfigs {
+		for _, name := range nodePool.KubeletConfigs() {
+			if kubeletConfig.Name() == name && kubeletConfig.PodPidsLimit() != 0 {
+				tuning.podPidsLimit = kubeletConfig.PodPidsLimit()
+			}
+		}
+	}
+	for _, tuningConfig := range tuningConfigs {
+		for _, name := range nodePool.TuningConfigs() {
+			if tuningConfig.Name() == name {
+				tuning.sysctls = append(tuning.sysctls, tunedSysctls(tuningConfig.Spec())...)
+			}
+		}
+	}
+	return tuning
+}
+
+// tunedSysctls extracts the sysctls of the '[sysctl]' sections of the profiles of a Tuned spec
+func tunedSysctls(spec interface{}) []string {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil
+	}
+	tuned := struct {
+		Profile []struct {
+			Data string `json:"data"`
+		} `json:"profile"`
+	}{}
+	err = json.Unmarshal(data, &tuned)
+	if err != nil {
+		return nil
+	}
+	sysctls := []string{}
+	for _, profile := range tuned.Profile {
+		inSysctl := false
+		for _, line := range strings.Split(profile.Data, "\n") {
+			line = strings.TrimSpace(line)
+			if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+				inSysctl = line == "[sysctl]"
+				continue
+			}
+			if inSysctl && strings.Contains(line, "=") && !strings.HasPrefix(line, "#") {
+				key, value, _ := strings.Cut(line, "=")
+				sysctls = append(sysctls, strings.TrimSpace(key)+"="+strings.TrimSpace(value))
+			}
+		}
+	}
+	return sysctls
+}
+
+// poolsTuningDescription lists the pools with customized PID limits or sysctls
+func poolsTuningDescription(tuning map[string]poolTuning) string {
+	if len(tuning) == 0 {
+		return ""
+	}
+	str := "Node Tuning:\n"
+	for _, poolID := range sortedKeys(tuning) {
+		settings := []string{}
+		if tuning[poolID].podPidsLimit != 0 {
+			settings = append(settings, fmt.Sprintf("pod PIDs limit %d", tuning[poolID].podPidsLimit))
+		}
+		if len(tuning[poolID].sysctls) > 0 {
+			settings = append(settings, fmt.Sprintf("sysctls %s", strings.Join(tuning[poolID].sysctls, ", ")))
+		}
+		str += fmt.Sprintf(" - %s: %s\n", poolID, strings.Join(settings, "; "))
+	}
+	return str
+}
+
+func formatPoolsTuning(tuning map[string]poolTuning) map[string]interface{} {
+	f := map[string]interface{}{}
+	for poolID, poolTuning := range tuning {
+		item := map[string]interface{}{}
+		if poolTuning.podPidsLimit != 0 {
+			item["podPidsLimit"] = poolTuning.podPidsLimit
+		}
+		if len(poolTuning.sysctls) > 0 {
+			item["sysctls"] = poolTuning.sysctls
+		}
+		f[poolID] = item
+	}
+	return f
+}
@@ -0,0 +1,111 @@
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// pendingUpgrade is an upgrade policy of the cluster, or of its hosted control plane, with the
+// details that '--show-all-upgrades' shows
+type pendingUpgrade struct {
+	version      string
+	state        string
+	nextRun      time.Time
+	scheduleType string
+}
+
+// clusterUpgrades returns the upgrade policies of a classic cluster, the soonest first
+func clusterUpgrades(upgradePolicies []*cmv1.UpgradePolicy,
+	states map[string]*cmv1.UpgradePolicyState) []pendingUpgrade {
+	upgrades := []pendingUpgrade{}
+	for _, upgradePolicy := range upgradePolicies {
+		upgrades = append(upgrades, pendingUpgrade{
+			version:      upgradePolicy.Version(),
+			state:        string(states[upgradePolicy.ID()].Value()),
+			nextRun:      upgradePolicy.NextRun(),
+			scheduleType: string(upgradePolicy.ScheduleType()),
+		})
+	}
+	sortUpgrades(upgrades)
+	return upgrades
+}
+
+// controlPlaneUpgrades returns the upgrade policies of a hosted control plane, the soonest first
+func controlPlaneUpgrades(upgradePolicies []*cmv1.ControlPlaneUpgradePolicy) []pendingUpgrade {
+	upgrades := []pendingUpgrade{}
+	for _, upgradePolicy := range upgradePolicies {
+		if upgradePolicy.UpgradeType() != cmv1.UpgradeTypeControlPlane {
+			continue
+		}
+		upgrades = append(upgrades, pendingUpgrade{
+			version:      upgradePolicy.Version(),
+			state:        string(upgradePolicy.State().Value()),
+			nextRun:      upgradePolicy.NextRun(),
+			scheduleType: string(upgradePolicy.ScheduleType()),
+		})
+	}
+	sortUpgrades(upgrades)
+	return upgrades
+}
+
+// nextUpgradePolicy returns the upgrade policy of a classic cluster that runs the soonest, the one
+// that the single scheduled upgrade shows
+func nextUpgradePolicy(upgradePolicies []*cmv1.UpgradePolicy) *cmv1.UpgradePolicy {
+	var next *cmv1.UpgradePolicy
+	for _, upgradePolicy := range upgradePolicies {
+		if next == nil || upgradePolicy.NextRun().Before(next.NextRun()) {
+			next = upgradePolicy
+		}
+	}
+	return next
+}
+
+// nextControlPlaneUpgradePolicy returns the upgrade policy of a hosted control plane that runs the
+// soonest, the one that the single scheduled upgrade shows
+func nextControlPlaneUpgradePolicy(
+	upgradePolicies []*cmv1.ControlPlaneUpgradePolicy) *cmv1.ControlPlaneUpgradePolicy {
+	var next *cmv1.ControlPlaneUpgradePolicy
+	for _, upgradePolicy := range upgradePolicies {
+		if upgradePolicy.UpgradeType() != cmv1.UpgradeTypeControlPlane {
+			continue
+		}
+		if next == nil || upgradePolicy.NextRun().Before(next.NextRun()) {
+			next = upgradePolicy
+		}
+	}
+	return next
+}
+
+func sortUpgrades(upgrades []pendingUpgrade) {
+	sort.SliceStable(upgrades, func(i, j int) bool {
+		return upgrades[i].nextRun.Before(upgrades[j].nextRun)
+	})
+}
+
+func scheduledUpgradesDescription(upgrades []pendingUpgrade) string {
+	if len(upgrades) == 0 {
+		return ""
+	}
+	str := "Scheduled Upgrades:\n"
+	for _, upgrade := range upgrades {
+		str += fmt.Sprintf(" - %s %s on %s (%s)\n", upgrade.state, upgrade.version,
+			upgrade.nextRun.Format("2006-01-02 15:04 MST"), upgrade.scheduleType)
+	}
+	return str
+}
+
+func formatScheduledUpgrades(upgrades []pendingUpgrade) []map[string]string {
+	formatted := []map[string]string{}
+	for _, upgrade := range upgrades {
+		formatted = append(formatted, map[string]string{
+			"version":      upgrade.version,
+			"state":        upgrade.state,
+			"nextRun":      upgrade.nextRun.Format("2006-01-02 15:04 MST"),
+			"scheduleType": upgrade.scheduleType,
+		})
+	}
+	return formatted
+}
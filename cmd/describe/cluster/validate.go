@@ -0,0 +1,186 @@
+package cluster
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	awserr "github.com/openshift-online/ocm-common/pkg/aws/errors"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// validationCheck is the result of one of the read-only consistency checks of '--validate'. The
+// check passed when there is no error.
+type validationCheck struct {
+	name string
+	err  error
+}
+
+// validateCluster runs the consistency checks of the cluster against AWS and OCM. They never
+// modify anything.
+func validateCluster(r *rosa.Runtime, cluster *cmv1.Cluster) []validationCheck {
+	checks := []validationCheck{}
+	for _, roleARN := range clusterRoleARNs(cluster) {
+		checks = append(checks, validationCheck{
+			name: fmt.Sprintf("Role '%s' exists", roleARN),
+			err:  validateRole(r, roleARN),
+		})
+	}
+	if oidcEndpointURL := cluster.AWS().STS().OIDCEndpointURL(); oidcEndpointURL != "" {
+		checks = append(checks, validationCheck{
+			name: fmt.Sprintf("OIDC endpoint '%s' is reachable", oidcEndpointURL),
+			err:  validateOIDCEndpoint(oidcEndpointURL, args.timeout),
+		})
+	}
+	if subnetIDs := cluster.AWS().SubnetIDs(); len(subnetIDs) > 0 {
+		_, err := lookupSubnets(r.AWSClient, subnetIDs)
+		checks = append(checks, validationCheck{
+			name: "Subnets exist",
+			err:  err,
+		})
+	}
+	checks = append(checks, validationCheck{
+		name: "Machine, service and pod CIDRs don't overlap",
+		err:  validateCIDRs(cluster.Network()),
+	})
+	if versionID := cluster.Version().ID(); versionID != "" {
+		checks = append(checks, validationCheck{
+			name: fmt.Sprintf("Version '%s' is supported", cluster.OpenshiftVersion()),
+			err:  validateVersion(r, versionID, time.Now()),
+		})
+	}
+	return checks
+}
+
+// clusterRoleARNs returns the account and operator roles of STS clusters
+func clusterRoleARNs(cluster *cmv1.Cluster) []string {
+	sts := cluster.AWS().STS()
+	roleARNs := []string{}
+	for _, roleARN := range []string{
+		sts.RoleARN(),
+		sts.SupportRoleARN(),
+		sts.InstanceIAMRoles().MasterRoleARN(),
+		sts.InstanceIAMRoles().WorkerRoleARN(),
+	} {
+		if roleARN != "" {
+			roleARNs = append(roleARNs, roleARN)
+		}
+	}
+	for _, operatorRole := range sts.OperatorIAMRoles() {
+		roleARNs = append(roleARNs, operatorRole.RoleARN())
+	}
+	return roleARNs
+}
+
+func validateRole(r *rosa.Runtime, roleARN string) error {
+	_, err := r.AWSClient.GetRoleByARN(roleARN)
+	if awserr.IsNoSuchEntityException(err) {
+		return fmt.Errorf("the role doesn't exist")
+	}
+	return err
+}
+
+func validateOIDCEndpoint(oidcEndpointURL string, timeout time.Duration) error {
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	response, err := client.Get(strings.TrimSuffix(oidcEndpointURL, "/") + "/.well-known/openid-configuration")
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("the discovery document responded with status '%s'", response.Status)
+	}
+	return nil
+}
+
+func validateCIDRs(network *cmv1.Network) error {
+	cidrs := [][2]string{
+		{"machine", network.MachineCIDR()},
+		{"service", network.ServiceCIDR()},
+		{"pod", network.PodCIDR()},
+	}
+	parsed := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		if cidr[1] == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr[1])
+		if err != nil {
+			return fmt.Errorf("invalid %s CIDR '%s'", cidr[0], cidr[1])
+		}
+		parsed[i] = ipNet
+	}
+	for i := range parsed {
+		for j := i + 1; j < len(parsed); j++ {
+			if parsed[i] == nil || parsed[j] == nil {
+				continue
+			}
+			if parsed[i].Contains(parsed[j].IP) || parsed[j].Contains(parsed[i].IP) {
+				return fmt.Errorf("the %s CIDR '%s' overlaps with the %s CIDR '%s'",
+					cidrs[i][0], cidrs[i][1], cidrs[j][0], cidrs[j][1])
+			}
+		}
+	}
+	return nil
+}
+
+func validateVersion(r *rosa.Runtime, versionID string, now time.Time) error {
+	version, err := r.OCMClient.GetVersion(versionID)
+	if err != nil {
+		return err
+	}
+	if !version.EndOfLifeTimestamp().IsZero() && version.EndOfLifeTimestamp().Before(now) {
+		return fmt.Errorf("the version reached its end of life on %s",
+			version.EndOfLifeTimestamp().Format(time.DateOnly))
+	}
+	return nil
+}
+
+func validationPassed(checks []validationCheck) bool {
+	for _, check := range checks {
+		if check.err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// validationReport renders the checks as a PASS/FAIL list, with the reason of the failures
+func validationReport(cluster *cmv1.Cluster, checks []validationCheck) string {
+	str := fmt.Sprintf("Validation of cluster '%s' (%s):\n", cluster.Name(), cluster.ID())
+	for _, check := range checks {
+		if check.err != nil {
+			str += fmt.Sprintf(" FAIL  %s: %v\n", check.name, check.err)
+		} else {
+			str += fmt.Sprintf(" PASS  %s\n", check.name)
+		}
+	}
+	return str
+}
+
+func formatValidation(cluster *cmv1.Cluster, checks []validationCheck) map[string]interface{} {
+	list := []map[string]string{}
+	for _, check := range checks {
+		item := map[string]string{
+			"name":   check.name,
+			"result": "pass",
+		}
+		if check.err != nil {
+			item["result"] = "fail"
+			item["reason"] = check.err.Error()
+		}
+		list = append(list, item)
+	}
+	return map[string]interface{}{
+		"id":     cluster.ID(),
+		"name":   cluster.Name(),
+		"passed": validationPassed(checks),
+		"checks": list,
+	}
+}
@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"fmt"
+)
+
+// outputVersion is a version of the schema of the JSON and YAML description of the cluster.
+// Every version lists the keys that the describe command adds on top of the cluster resource,
+// so that pinning an older version drops the keys added by the newer ones.
+type outputVersion struct {
+	name string
+	keys []string
+}
+
+// outputVersions contains the versions of the schema, oldest first. The last one is the default,
+// and the keys added to the description go to its list.
+var outputVersions = []outputVersion{
+	{
+		name: "v1",
+		keys: []string{
+			"capabilities",
+			"clusterAdminConfigured",
+			"controlPlaneInstanceType",
+			"controlPlaneType",
+			"displayName",
+			"display_name",
+			"endpointVisibility",
+			"nodePools",
+			"scheduledUpgrade",
+			"subnets",
+			"zoneTypes",
+		},
+	},
+	{
+		name: "v2",
+		keys: []string{
+			"addOns",
+			"apiLatencyMs",
+			"autoscalerScaleDown",
+			"awsPartition",
+			"breakGlassCredentials",
+			"creationMode",
+			"customIngressDomains",
+			"defaultIngressLoadBalancer",
+			"defaultStorageClass",
+			"derivedConditions",
+			"disableUserWorkloadMonitoring",
+			"fips",
+			"imdsv2Required",
+			"loginUrl",
+			"managementCluster",
+			"nodeDrainGracePeriods",
+			"nodePoolDifferences",
+			"nodePoolReadiness",
+			"nodePoolUpgradeSettings",
+			"nodePoolVersions",
+			"nodeTuning",
+			"policyVersion",
+			"rootVolumeEncryption",
+			"rootVolumeIOPS",
+			"scalingActivity",
+			"scheduledUpgrades",
+			"statusCode",
+			"subnetTags",
+			"subscriptionStatus",
+			"warnings",
+		},
+	},
+}
+
+func outputVersionNames() []string {
+	names := []string{}
+	for _, version := range outputVersions {
+		names = append(names, version.name)
+	}
+	return names
+}
+
+func latestOutputVersion() string {
+	return outputVersions[len(outputVersions)-1].name
+}
+
+// pinOutputVersion removes from the description the keys added after the given version. Keys that
+// the cluster resource itself contains, like 'fips', are kept even if a newer version also sets them.
+func pinOutputVersion(f map[string]interface{}, name string, resourceKeys map[string]bool) error {
+	for i, version := range outputVersions {
+		if version.name != name {
+			continue
+		}
+		for _, newer := range outputVersions[i+1:] {
+			for _, key := range newer.keys {
+				if !resourceKeys[key] {
+					delete(f, key)
+				}
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("Unknown output version '%s'. Allowed versions are %s", name, outputVersionNames())
+}
@@ -0,0 +1,77 @@
+package cluster
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// Stable codes of the warnings in the 'warnings' key of the JSON output, so that monitoring systems
+// can alert on specific warnings without parsing the messages
+const (
+	warningDefaultPoolTaints   = "default_pool_taints"
+	warningNodePoolVersionSkew = "node_pool_version_skew"
+	warningZoneImbalance       = "zone_imbalance"
+	warningSingleZone          = "single_zone_data_plane"
+	warningNoSchedulablePool   = "no_schedulable_pool"
+)
+
+// clusterWarning is a problem of the configuration of the cluster that doesn't break it, but that
+// is worth fixing
+type clusterWarning struct {
+	code    string
+	message string
+}
+
+// warningLines renders the warnings the way they are shown in the description
+func warningLines(warnings []clusterWarning) string {
+	str := ""
+	for _, warning := range warnings {
+		str += fmt.Sprintf("⚠ %s\n", warning.message)
+	}
+	return str
+}
+
+// singleZoneDataPlane explains when all the node pools of a hosted control plane cluster are in the
+// same availability zone, although the region has several
+func singleZoneDataPlane(cluster *cmv1.Cluster, nodePools []*cmv1.NodePool, regionSupportsMultiAZ bool) string {
+	if !regionSupportsMultiAZ || len(nodePools) == 0 || len(nodePoolsZones(nodePools)) != 1 {
+		return ""
+	}
+	return fmt.Sprintf("every node pool is in availability zone '%s', so the data plane is effectively "+
+		"single-AZ although region '%s' has several zones",
+		nodePools[0].AvailabilityZone(), cluster.Region().ID())
+}
+
+// clusterWarnings collects the warnings that the description shows in different sections
+func clusterWarnings(cluster *cmv1.Cluster, machinePools []*cmv1.MachinePool, nodePools []*cmv1.NodePool,
+	regionSupportsMultiAZ bool) []clusterWarning {
+	warnings := []clusterWarning{}
+	warnings = append(warnings, noSchedulablePoolWarnings(machinePools, nodePools)...)
+	warnings = append(warnings, defaultPoolsTaintsWarnings(machinePools, nodePools)...)
+	warnings = append(warnings, nodePoolsVersionSkewWarnings(cluster, nodePools)...)
+	for _, imbalance := range zoneImbalances(machinePools, nodePools) {
+		warnings = append(warnings, clusterWarning{
+			code:    warningZoneImbalance,
+			message: imbalance,
+		})
+	}
+	if note := singleZoneDataPlane(cluster, nodePools, regionSupportsMultiAZ); note != "" {
+		warnings = append(warnings, clusterWarning{
+			code:    warningSingleZone,
+			message: note,
+		})
+	}
+	return warnings
+}
+
+func formatWarnings(warnings []clusterWarning) []map[string]string {
+	formatted := []map[string]string{}
+	for _, warning := range warnings {
+		formatted = append(formatted, map[string]string{
+			"code":    warning.code,
+			"message": warning.message,
+		})
+	}
+	return formatted
+}
@@ -0,0 +1,68 @@
+package cluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+func validateWebhook(webhook string) error {
+	parsed, err := url.ParseRequestURI(webhook)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("Invalid webhook URL '%s': it must be an absolute HTTP or HTTPS URL", webhook)
+	}
+	return nil
+}
+
+// sendWebhooks posts the JSON description of each cluster to the webhook, when one was given and
+// it isn't a dry run.
+// Failures are reported as warnings, unless the webhook is strict, in which case they are errors
+// and the result is false.
+func sendWebhooks(r *rosa.Runtime, descriptions []*clusterDescription) bool {
+	if args.webhook == "" || args.dryRun {
+		return true
+	}
+	ok := true
+	for _, description := range descriptions {
+		if description.f == nil {
+			continue
+		}
+		err := sendWebhook(args.webhook, args.timeout, description.f)
+		if err == nil {
+			continue
+		}
+		if args.webhookStrict {
+			r.Reporter.Errorf("Failed to send the description of cluster '%s' to the webhook: %v",
+				description.cluster.Name(), err)
+			ok = false
+		} else {
+			r.Reporter.Warnf("Failed to send the description of cluster '%s' to the webhook: %v",
+				description.cluster.Name(), err)
+		}
+	}
+	return ok
+}
+
+func sendWebhook(webhook string, timeout time.Duration, payload map[string]interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	response, err := client.Post(webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("the webhook responded with status '%s'", response.Status)
+	}
+	return nil
+}
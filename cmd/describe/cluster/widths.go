@@ -0,0 +1,38 @@
+package cluster
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	formatWidthsLegacy  = "legacy"
+	formatWidthsCompact = "compact"
+)
+
+var formatWidthsOptions = []string{formatWidthsLegacy, formatWidthsCompact}
+
+// Matches the lines of the description made of a label, top level or nested under a list item,
+// followed by the padding and the value. Labels start with a letter or a digit, so that warnings
+// like '⚠ No schedulable default pool: ...' aren't taken for labels.
+var labelLineRE = regexp.MustCompile(`^((?: - )?[\p{L}\p{N}][^:]*:)( +)(\S.*)$`)
+
+// compactLabelWidths aligns the values of the description to the longest label actually printed,
+// instead of the fixed width of the legacy layout
+func compactLabelWidths(str string) string {
+	lines := strings.Split(str, "\n")
+	width := 0
+	for _, line := range lines {
+		if match := labelLineRE.FindStringSubmatch(line); match != nil {
+			width = max(width, utf8.RuneCountInString(match[1]))
+		}
+	}
+	for i, line := range lines {
+		if match := labelLineRE.FindStringSubmatch(line); match != nil {
+			padding := strings.Repeat(" ", width-utf8.RuneCountInString(match[1])+1)
+			lines[i] = match[1] + padding + match[3]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
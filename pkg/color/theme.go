@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the color themes used to highlight the human readable output.
+
+package color
+
+import (
+	"fmt"
+	"os"
+)
+
+// Theme is a palette of SGR escape sequence parameters. An empty parameter leaves the text as is.
+type Theme struct {
+	Name     string
+	Header   string
+	Warning  string
+	Success  string
+	Progress string
+	Failure  string
+}
+
+var themes = []Theme{
+	{
+		Name:     "default",
+		Header:   "1",
+		Warning:  "33",
+		Success:  "32",
+		Progress: "33",
+		Failure:  "31",
+	},
+	{
+		// Bright colors, readable on dark backgrounds:
+		Name:     "dark",
+		Header:   "1;97",
+		Warning:  "93",
+		Success:  "92",
+		Progress: "96",
+		Failure:  "91",
+	},
+	{
+		// Dark colors, readable on light backgrounds:
+		Name:     "light",
+		Header:   "1;30",
+		Warning:  "35",
+		Success:  "32",
+		Progress: "34",
+		Failure:  "31",
+	},
+	{
+		// No colors, only bold and underline:
+		Name:     "mono",
+		Header:   "1",
+		Warning:  "4",
+		Success:  "",
+		Progress: "",
+		Failure:  "1",
+	},
+}
+
+// ThemeNames returns the names of the themes, the default one first
+func ThemeNames() []string {
+	names := []string{}
+	for _, theme := range themes {
+		names = append(names, theme.Name)
+	}
+	return names
+}
+
+// GetTheme returns the theme with the given name
+func GetTheme(name string) (Theme, error) {
+	for _, theme := range themes {
+		if theme.Name == name {
+			return theme, nil
+		}
+	}
+	return Theme{}, fmt.Errorf("Invalid color theme '%s'. Allowed options are %s", name, ThemeNames())
+}
+
+// UseTheme returns a bool that indicates whether the output should be highlighted with a theme. It
+// honors the '--color' option and the NO_COLOR convention.
+func UseTheme() bool {
+	return UseColor() && os.Getenv("NO_COLOR") == ""
+}
+
+// Paint surrounds the text with the escape sequences of the given parameter
+func Paint(sgr string, text string) string {
+	if sgr == "" {
+		return text
+	}
+	return fmt.Sprintf("\x1b[%sm%s\x1b[0m", sgr, text)
+}
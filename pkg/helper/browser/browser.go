@@ -0,0 +1,38 @@
+package browser
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// ErrNoBrowser is returned when there is no browser to open, like in remote hosts and containers
+var ErrNoBrowser = errors.New("no browser is available")
+
+// Open opens the URL in the default browser of the system, without waiting for it to be closed
+func Open(url string) error {
+	cmd, err := command(runtime.GOOS, url)
+	if err != nil {
+		return err
+	}
+	return cmd.Start()
+}
+
+func command(goos string, url string) (*exec.Cmd, error) {
+	switch goos {
+	case "darwin":
+		return exec.Command("open", url), nil
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url), nil
+	default:
+		if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+			return nil, ErrNoBrowser
+		}
+		path, err := exec.LookPath("xdg-open")
+		if err != nil {
+			return nil, ErrNoBrowser
+		}
+		return exec.Command(path, url), nil
+	}
+}
@@ -0,0 +1,27 @@
+package browser
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Browser command", func() {
+	It("Uses 'open' in macOS", func() {
+		cmd, err := command("darwin", "https://example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmd.Args).To(Equal([]string{"open", "https://example.com"}))
+	})
+
+	It("Uses the URL protocol handler in Windows", func() {
+		cmd, err := command("windows", "https://example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmd.Args).To(Equal([]string{"rundll32", "url.dll,FileProtocolHandler", "https://example.com"}))
+	})
+
+	It("Fails in headless systems", func() {
+		GinkgoT().Setenv("DISPLAY", "")
+		GinkgoT().Setenv("WAYLAND_DISPLAY", "")
+		_, err := command("linux", "https://example.com")
+		Expect(err).To(MatchError(ErrNoBrowser))
+	})
+})
@@ -18,6 +18,8 @@ package ocm
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -33,7 +35,8 @@ import (
 )
 
 type Client struct {
-	ocm *sdk.Connection
+	ocm       *sdk.Connection
+	rateLimit *rateLimit
 }
 
 // ClientBuilder contains the information and logic needed to build a connection to OCM. Don't
@@ -143,6 +146,26 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 	}
 	builder.Insecure(b.cfg.Insecure)
 
+	// Honor the delays requested by the server when requests are rate limited:
+	settings := &rateLimit{
+		timeout: defaultRateLimitTimeout,
+	}
+	builder.TransportWrapper(newRetryAfterTransportWrapper(b.logger, settings))
+
+	// The connection honors the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+	// variables, report the proxy that will be used so that it shows up in the debug output:
+	apiURL := sdk.DefaultURL
+	if b.cfg.URL != "" {
+		apiURL = b.cfg.URL
+	}
+	proxy, err := proxyURL(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to determine the proxy for '%s': %v", apiURL, err)
+	}
+	if proxy != nil {
+		b.logger.Debugf("Using proxy '%s' to connect to '%s'", proxy.Redacted(), apiURL)
+	}
+
 	// Create the connection:
 	conn, err := builder.Build()
 	if err != nil {
@@ -157,10 +180,29 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 		return nil, fmt.Errorf("error creating connection. Not able to get authentication token: %s", err)
 	}
 	return &Client{
-		ocm: conn,
+		ocm:       conn,
+		rateLimit: settings,
 	}, nil
 }
 
+// SetRateLimitTimeout sets how long the requests that are rate limited are sent again before
+// giving up
+func (c *Client) SetRateLimitTimeout(timeout time.Duration) {
+	if c.rateLimit != nil {
+		c.rateLimit.timeout = timeout
+	}
+}
+
+// proxyURL returns the proxy selected by the environment for the given API URL, or nil when the
+// requests are sent directly
+func proxyURL(apiURL string) (*url.URL, error) {
+	request, err := http.NewRequest(http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return http.ProxyFromEnvironment(request)
+}
+
 func (c *Client) Close() error {
 	return c.ocm.Close()
 }
@@ -22,6 +22,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
@@ -377,6 +378,24 @@ func (c *Client) GetSubscriptionBySubscriptionID(id string) (*amv1.Subscription,
 	return response.Body(), true, nil
 }
 
+// GetSubscriptionWithCapabilities gets the subscription with the given ID, including the
+// capabilities enabled for it, which the server only returns when requested
+func (c *Client) GetSubscriptionWithCapabilities(id string) (*amv1.Subscription, bool, error) {
+	response, err := c.ocm.AccountsMgmt().V1().Subscriptions().Subscription(id).
+		Get().
+		Parameter("fetchCapabilities", true).
+		Send()
+
+	if err != nil {
+		return nil, false, err
+	}
+	if response.Body() == nil {
+		return &amv1.Subscription{}, false, nil
+	}
+
+	return response.Body(), true, nil
+}
+
 func (c *Client) GetClusterByID(clusterKey string, creator *aws.Creator) (*cmv1.Cluster, error) {
 	query := fmt.Sprintf("%s AND id = '%s'",
 		getClusterFilter(creator),
@@ -401,6 +420,58 @@ func (c *Client) GetClusterByID(clusterKey string, creator *aws.Creator) (*cmv1.
 	}
 }
 
+// GetClusterByExternalID returns the cluster with the given external identifier, which is the
+// identifier that the cluster itself knows, as in its 'ClusterVersion' resource
+func (c *Client) GetClusterByExternalID(externalID string, creator *aws.Creator) (*cmv1.Cluster, error) {
+	query := fmt.Sprintf("%s AND external_id = '%s'",
+		getClusterFilter(creator),
+		strings.ReplaceAll(externalID, "'", "''"),
+	)
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().List().
+		Search(query).
+		Page(1).
+		Size(1).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+
+	switch response.Total() {
+	case 0:
+		return nil, errors.NotFound.Errorf("There is no cluster with external identifier '%s'", externalID)
+	case 1:
+		return response.Items().Slice()[0], nil
+	default:
+		return nil, fmt.Errorf("There are %d clusters with external identifier '%s'", response.Total(), externalID)
+	}
+}
+
+// GetClusterByAPIURL returns the cluster whose API server has the given URL, as written in the
+// kubeconfig files used to connect to it
+func (c *Client) GetClusterByAPIURL(apiURL string, creator *aws.Creator) (*cmv1.Cluster, error) {
+	query := fmt.Sprintf("%s AND api.url = '%s'",
+		getClusterFilter(creator),
+		strings.ReplaceAll(apiURL, "'", "''"),
+	)
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().List().
+		Search(query).
+		Page(1).
+		Size(1).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+
+	switch response.Total() {
+	case 0:
+		return nil, errors.NotFound.Errorf("There is no cluster with API URL '%s'", apiURL)
+	case 1:
+		return response.Items().Slice()[0], nil
+	default:
+		return nil, fmt.Errorf("There are %d clusters with API URL '%s'", response.Total(), apiURL)
+	}
+}
+
 func (c *Client) GetClusterUsingSubscription(clusterKey string, creator *aws.Creator) (*amv1.Subscription, error) {
 	query := fmt.Sprintf("(plan.id = 'MOA' OR plan.id = 'MOA-HostedControlPlane')"+
 		" AND (display_name  = '%s' OR cluster_id = '%s') AND status = 'Deprovisioned'", clusterKey, clusterKey)
@@ -533,6 +604,20 @@ func (c *Client) GetClusterState(clusterID string) (cmv1.ClusterState, error) {
 	return response.Body().State(), nil
 }
 
+// GetClusterHypershiftConfig returns the hosted control plane settings of the cluster, including
+// the management cluster that hosts it. Only SREs are allowed to read them.
+func (c *Client) GetClusterHypershiftConfig(clusterID string) (*cmv1.HypershiftConfig, error) {
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(clusterID).
+		Hypershift().
+		Get().
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
 func (c *Client) getClusterNodesBuilder(config Spec) (clusterNodesBuilder *cmv1.ClusterNodesBuilder, updateNodes bool) {
 
 	clusterNodesBuilder = cmv1.NewClusterNodes()
@@ -756,6 +841,9 @@ func (c *Client) createClusterSpec(config Spec) (*cmv1.Cluster, error) {
 
 	clusterProperties[ocmConsts.CreatorArn] = config.AWSCreator.ARN
 	clusterProperties[properties.CLIVersion] = info.Version
+	if config.IsSTS && config.Mode != "" {
+		clusterProperties[properties.CreationMode] = config.Mode
+	}
 
 	// Create the cluster:
 	clusterBuilder := cmv1.NewCluster().
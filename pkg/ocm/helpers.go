@@ -205,7 +205,38 @@ func handleErr(res *ocmerrors.Error, err error) error {
 			"Once you accept the terms, you will need to retry the action that was blocked."
 	}
 	errType := errors.ErrorType(res.Status())
-	return errType.Set(errors.Errorf("%s", msg))
+	handledErr := errType.Set(errors.Errorf("%s", msg))
+	if details := requestDetails(res); details != nil {
+		handledErr = errType.AddDetails(handledErr, details)
+	}
+	return handledErr
+}
+
+// RequestDetails identifies the OCM request that failed, so that it can be referenced in support
+// tickets
+type RequestDetails struct {
+	Status      int
+	OperationID string
+}
+
+func requestDetails(res *ocmerrors.Error) *RequestDetails {
+	if res.Status() == 0 && res.OperationID() == "" {
+		return nil
+	}
+	return &RequestDetails{
+		Status:      res.Status(),
+		OperationID: res.OperationID(),
+	}
+}
+
+// GetRequestDetails returns the details of the OCM request that caused the error, if any
+func GetRequestDetails(err error) *RequestDetails {
+	for _, details := range errors.GetDetails(err) {
+		if requestDetails, ok := details.(*RequestDetails); ok {
+			return requestDetails
+		}
+	}
+	return nil
 }
 
 func (c *Client) GetDefaultClusterFlavors(flavour string) (dMachinecidr *net.IPNet, dPodcidr *net.IPNet,
@@ -30,6 +30,7 @@ import (
 	ocmCommonValidations "github.com/openshift-online/ocm-common/pkg/ocm/validations"
 	commonUtils "github.com/openshift-online/ocm-common/pkg/utils"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
 
 	mock "github.com/openshift/rosa/pkg/aws"
 )
@@ -519,3 +520,21 @@ var _ = Describe("ValidateClaimValidationRules()", func() {
 		Entry("should not error when claim validation rule with single pair is valid", "abc:efg", false, ""))
 	Entry("should not error when claim validation rule with multiple pairs is valid", "abc:efg,lala:wuwu", false, "")
 })
+
+var _ = Describe("handleErr", func() {
+	It("Keeps the status and operation ID of the failed request", func() {
+		res, err := ocmerrors.NewError().Status(404).OperationID("a1b2c3").
+			Reason("Cluster 'abc' not found").Build()
+		Expect(err).NotTo(HaveOccurred())
+		handledErr := handleErr(res, fmt.Errorf("not found"))
+		Expect(handledErr).To(MatchError("Cluster 'abc' not found"))
+		Expect(GetRequestDetails(handledErr)).To(Equal(&RequestDetails{
+			Status:      404,
+			OperationID: "a1b2c3",
+		}))
+	})
+
+	It("Has no request details when there is no response", func() {
+		Expect(GetRequestDetails(handleErr(nil, fmt.Errorf("connection refused")))).To(BeNil())
+	})
+})
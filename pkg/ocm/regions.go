@@ -74,6 +74,17 @@ func (c *Client) getFilteredRegions(cloudProviderData *cmv1.CloudProviderData) (
 	return cloudRegions, nil
 }
 
+// GetRegion returns the AWS region with the given identifier, including whether it supports
+// multiple availability zones
+func (c *Client) GetRegion(regionID string) (*cmv1.CloudRegion, error) {
+	response, err := c.ocm.ClustersMgmt().V1().CloudProviders().CloudProvider("aws").
+		Regions().Region(regionID).Get().Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
 func (c *Client) GetRegions(roleARN string, externalID string) (regions []*cmv1.CloudRegion, err error) {
 	// Retrieve AWS credentials from the local AWS user
 	// pass these to OCM to validate what regions are available
@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultRateLimitTimeout is how long a rate limited request is sent again before giving up,
+	// unless the command sets a different timeout
+	defaultRateLimitTimeout = time.Minute
+
+	// rateLimitAttempts is the number of times that a rate limited request is sent, including the
+	// first one
+	rateLimitAttempts = 4
+
+	// rateLimitInterval is the delay before sending again a rate limited request when the server
+	// doesn't request one. It's doubled for each attempt.
+	rateLimitInterval = time.Second
+)
+
+// rateLimit contains the settings of the handling of rate limited requests, shared by the client
+// and its transport so that commands can change them after the connection is created
+type rateLimit struct {
+	timeout time.Duration
+}
+
+// retryAfterTransport sends again the requests that were rejected with '429 Too Many Requests',
+// waiting the time requested by the server in the 'Retry-After' header. The SDK puts it under its
+// own retry wrapper, which also retries 429 responses, so it never returns them: when it gives up
+// it returns an error instead, that the SDK doesn't retry.
+type retryAfterTransport struct {
+	logger   *logrus.Logger
+	settings *rateLimit
+	wrapped  http.RoundTripper
+}
+
+func newRetryAfterTransportWrapper(logger *logrus.Logger,
+	settings *rateLimit) func(http.RoundTripper) http.RoundTripper {
+	return func(wrapped http.RoundTripper) http.RoundTripper {
+		return &retryAfterTransport{
+			logger:   logger,
+			settings: settings,
+			wrapped:  wrapped,
+		}
+	}
+}
+
+func (t *retryAfterTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	start := time.Now()
+	deadline := start.Add(t.settings.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	// The body is consumed by every attempt, so it's kept in memory to send it again:
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		request = request.Clone(ctx)
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	for attempt := 1; ; attempt++ {
+		response, err := t.wrapped.RoundTrip(request)
+		if err != nil || response.StatusCode != http.StatusTooManyRequests {
+			return response, err
+		}
+		response.Body.Close()
+		delay, ok := parseRetryAfter(response.Header.Get("Retry-After"), time.Now())
+		if !ok {
+			delay = backoffDelay(attempt)
+		}
+		if attempt >= rateLimitAttempts || time.Now().Add(delay).After(deadline) {
+			return nil, fmt.Errorf("request for method %s and URL '%s' is still rate limited after "+
+				"%d attempts in %s", request.Method, request.URL, attempt, time.Since(start).Round(time.Second))
+		}
+
+		t.logger.Debugf("Request for method %s and URL '%s' was rate limited, will try again in %s",
+			request.Method, request.URL, delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		if body != nil {
+			request = request.Clone(ctx)
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+}
+
+// backoffDelay returns the delay before the given attempt when the server doesn't request one
+func backoffDelay(attempt int) time.Duration {
+	return rateLimitInterval << (attempt - 1)
+}
+
+// parseRetryAfter returns the delay requested by a 'Retry-After' header, which contains either a
+// number of seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	delay := date.Sub(now)
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
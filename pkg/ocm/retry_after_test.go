@@ -0,0 +1,119 @@
+package ocm
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Retry-After handling", func() {
+	var (
+		server   *httptest.Server
+		attempts int
+		bodies   []string
+		headers  []string
+	)
+
+	BeforeEach(func() {
+		attempts = 0
+		bodies = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			if headers == nil || attempts < len(headers) {
+				if headers != nil {
+					w.Header().Set("Retry-After", headers[attempts])
+				}
+				attempts++
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			attempts++
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	send := func(method string, body io.Reader) (*http.Response, error) {
+		transport := newRetryAfterTransportWrapper(logrus.New(),
+			&rateLimit{timeout: time.Minute})(http.DefaultTransport)
+		request, err := http.NewRequest(method, server.URL, body)
+		Expect(err).NotTo(HaveOccurred())
+		return transport.RoundTrip(request)
+	}
+
+	It("Sends the request again after the requested delay", func() {
+		headers = []string{"0", "0"}
+		response, err := send(http.MethodGet, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(attempts).To(Equal(3))
+	})
+
+	It("Sends the body again", func() {
+		headers = []string{"0"}
+		response, err := send(http.MethodPost, strings.NewReader(`{"id":"123"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(bodies).To(Equal([]string{`{"id":"123"}`, `{"id":"123"}`}))
+	})
+
+	It("Gives up when the delay goes past the timeout", func() {
+		headers = []string{"3600"}
+		_, err := send(http.MethodGet, nil)
+		Expect(err).To(MatchError(ContainSubstring("is still rate limited after 1 attempts")))
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("Gives up after the attempt limit", func() {
+		headers = []string{"0", "0", "0", "0", "0", "0"}
+		_, err := send(http.MethodGet, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(attempts).To(Equal(rateLimitAttempts))
+	})
+
+	It("Isn't multiplied by the retries of the SDK", func() {
+		headers = make([]string, 20)
+		for i := range headers {
+			headers[i] = "0"
+		}
+		logger, err := logging.NewGoLoggerBuilder().Build()
+		Expect(err).NotTo(HaveOccurred())
+		connection, err := sdk.NewConnectionBuilder().
+			Logger(logger).
+			Tokens(MakeTokenString("Bearer", 15*time.Minute)).
+			URL(server.URL).
+			RetryInterval(time.Millisecond).
+			TransportWrapper(newRetryAfterTransportWrapper(logrus.New(), &rateLimit{timeout: time.Minute})).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		defer connection.Close()
+		_, err = connection.ClustersMgmt().V1().Clusters().Cluster("123").Get().Send()
+		Expect(err).To(MatchError(ContainSubstring("is still rate limited")))
+		Expect(attempts).To(Equal(rateLimitAttempts))
+	})
+
+	It("Parses seconds and dates", func() {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		delay, ok := parseRetryAfter("5", now)
+		Expect(ok).To(BeTrue())
+		Expect(delay).To(Equal(5 * time.Second))
+		delay, ok = parseRetryAfter("Mon, 01 Jan 2024 00:00:10 GMT", now)
+		Expect(ok).To(BeTrue())
+		Expect(delay).To(Equal(10 * time.Second))
+		_, ok = parseRetryAfter("soon", now)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"fmt"
+	"time"
+
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+)
+
+// GetClusterServiceLogs returns the service log entries of the cluster with a timestamp after the
+// given time, the newest first
+func (c *Client) GetClusterServiceLogs(clusterID string, since time.Time) ([]*slv1.LogEntry, error) {
+	response, err := c.ocm.ServiceLogs().V1().Clusters().ClusterLogs().List().
+		ClusterID(clusterID).
+		Search(fmt.Sprintf("timestamp >= '%s'", since.UTC().Format(time.RFC3339))).
+		Order("timestamp desc").
+		Page(1).
+		Size(100).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Items().Slice(), nil
+}
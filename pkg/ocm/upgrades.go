@@ -71,6 +71,35 @@ func (c *Client) GetScheduledUpgrade(clusterID string) (*cmv1.UpgradePolicy, *cm
 	return nil, nil, nil
 }
 
+// GetAllUpgradePolicies returns all the upgrade policies of the cluster that upgrade the cluster
+// itself, and the state of each of them keyed by the identifier of the policy
+func (c *Client) GetAllUpgradePolicies(clusterID string) ([]*cmv1.UpgradePolicy,
+	map[string]*cmv1.UpgradePolicyState, error) {
+	upgradePolicies, err := c.GetUpgradePolicies(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	clusterUpgradePolicies := []*cmv1.UpgradePolicy{}
+	states := map[string]*cmv1.UpgradePolicyState{}
+	for _, upgradePolicy := range upgradePolicies {
+		if upgradePolicy.UpgradeType() != cmv1.UpgradeTypeOSD {
+			continue
+		}
+		response, err := c.ocm.ClustersMgmt().V1().
+			Clusters().Cluster(clusterID).
+			UpgradePolicies().UpgradePolicy(upgradePolicy.ID()).
+			State().
+			Get().
+			Send()
+		if err != nil {
+			return nil, nil, handleErr(response.Error(), err)
+		}
+		clusterUpgradePolicies = append(clusterUpgradePolicies, upgradePolicy)
+		states[upgradePolicy.ID()] = response.Body()
+	}
+	return clusterUpgradePolicies, states, nil
+}
+
 func (c *Client) ScheduleUpgrade(clusterID string, upgradePolicy *cmv1.UpgradePolicy) error {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).
@@ -415,6 +415,15 @@ func CheckAndParseVersion(availableUpgrades []string, version string, cluster *c
 	return availableUpgrades[0], nil
 }
 
+// GetVersion returns the version with the given identifier, such as 'openshift-v4.15.2'
+func (c *Client) GetVersion(versionID string) (*cmv1.Version, error) {
+	response, err := c.ocm.ClustersMgmt().V1().Versions().Version(versionID).Get().Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
 func (c *Client) IsVersionCloseToEol(daysAwayToCheck int, version string, channelGroup string) error {
 	collection := c.ocm.ClustersMgmt().V1().Versions()
 	filter := fmt.Sprintf("raw_id='%s'", GetRawVersionId(version))
@@ -26,14 +26,19 @@ import (
 
 const (
 	JSON           = "json"
+	JSONL          = "jsonl"
 	YAML           = "yaml"
+	HTML           = "html"
 	FLAG_NAME      = "output"
 	FLAG_SHORTHAND = "o"
+	PRETTY_FLAG    = "pretty"
 )
 
 var o string
 
-var formats = []string{JSON, YAML}
+var pretty = true
+
+var formats = []string{JSON, JSONL, YAML, HTML}
 
 // AddFlag adds the interactive flag to the given set of command line flags.
 func AddFlag(cmd *cobra.Command) {
@@ -45,6 +50,14 @@ func AddFlag(cmd *cobra.Command) {
 		fmt.Sprintf("Output format. Allowed formats are %s", formats),
 	)
 
+	cmd.Flags().BoolVar(
+		&pretty,
+		PRETTY_FLAG,
+		true,
+		"Indent the JSON and YAML output. Use '--pretty=false' to print it compactly in a single line, "+
+			"which for YAML is the flow style",
+	)
+
 	cmd.RegisterFlagCompletionFunc(FLAG_NAME, completion)
 }
 
@@ -64,3 +77,12 @@ func Output() string {
 func SetOutput(output string) {
 	o = output
 }
+
+// Pretty returns a boolean flag that indicates if the JSON and YAML output is indented.
+func Pretty() bool {
+	return pretty
+}
+
+func SetPretty(value bool) {
+	pretty = value
+}
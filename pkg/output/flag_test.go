@@ -1,6 +1,8 @@
 package output
 
 import (
+	"bytes"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
@@ -10,10 +12,12 @@ var _ = Describe("Output flag", func() {
 
 	BeforeEach(func() {
 		SetOutput("")
+		SetPretty(true)
 	})
 
 	AfterEach(func() {
 		SetOutput("")
+		SetPretty(true)
 	})
 
 	It("Adds flag to command", func() {
@@ -28,13 +32,13 @@ var _ = Describe("Output flag", func() {
 		Expect(flag.Name).To(Equal(FLAG_NAME))
 		Expect(flag.Shorthand).To(Equal(FLAG_SHORTHAND))
 		Expect(flag.Value.String()).To(Equal(""))
-		Expect(flag.Usage).To(Equal("Output format. Allowed formats are [json yaml]"))
+		Expect(flag.Usage).To(Equal("Output format. Allowed formats are [json jsonl yaml html]"))
 	})
 
 	It("Has a completion function", func() {
 		args, directive := completion(nil, nil, "")
-		Expect(len(args)).To(Equal(2))
-		Expect(args).To(ContainElements(JSON, YAML))
+		Expect(len(args)).To(Equal(4))
+		Expect(args).To(ContainElements(JSON, JSONL, YAML, HTML))
 
 		Expect(directive).To(Equal(cobra.ShellCompDirectiveDefault))
 	})
@@ -50,4 +54,50 @@ var _ = Describe("Output flag", func() {
 		Expect(HasFlag()).To(BeFalse())
 	})
 
+	It("Indents the output by default", func() {
+		cmd := &cobra.Command{}
+		AddFlag(cmd)
+
+		flag := cmd.Flag(PRETTY_FLAG)
+		Expect(flag).NotTo(BeNil())
+		Expect(flag.DefValue).To(Equal("true"))
+		Expect(Pretty()).To(BeTrue())
+	})
+
+	It("Prints compact JSON and flow style YAML without pretty", func() {
+		body := bytes.NewBufferString("{\n  \"id\": \"abc\",\n  \"nodes\": [\n    1,\n    2\n  ]\n}")
+		SetPretty(false)
+
+		SetOutput(JSON)
+		str, err := parseResource(*body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(str).To(Equal("{\"id\":\"abc\",\"nodes\":[1,2]}\n"))
+
+		SetOutput(YAML)
+		str, err = parseResource(*body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(str).To(Equal("{\"id\":\"abc\",\"nodes\":[1,2]}\n"))
+
+		SetPretty(true)
+		str, err = parseResource(*body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(str).To(Equal("id: abc\nnodes:\n- 1\n- 2\n"))
+	})
+
+	It("Prints each element of a list in a line with JSON Lines", func() {
+		SetOutput(JSONL)
+		body := bytes.NewBufferString("[\n  {\n    \"id\": \"abc\"\n  },\n  {\n    \"id\": \"def\"\n  }\n]")
+		str, err := parseResource(*body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(str).To(Equal("{\"id\":\"abc\"}\n{\"id\":\"def\"}\n"))
+
+		str, err = parseResource(*bytes.NewBufferString("{\n  \"id\": \"abc\"\n}"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(str).To(Equal("{\"id\":\"abc\"}\n"))
+
+		str, err = parseResource(*bytes.NewBufferString("[]"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(str).To(BeEmpty())
+	})
+
 })
@@ -0,0 +1,61 @@
+package output
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Flatten converts the nested objects of the JSON representation of the resource into a single
+// object with dotted keys, like 'aws.sts.role_arn'. The elements of arrays get their index as key.
+// When the resource is a list, each of its elements is flattened.
+func Flatten(resource interface{}) (interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+	if items, ok := value.([]interface{}); ok {
+		flattened := []map[string]interface{}{}
+		for _, item := range items {
+			flat := map[string]interface{}{}
+			flatten("", item, flat)
+			flattened = append(flattened, flat)
+		}
+		return flattened, nil
+	}
+	flat := map[string]interface{}{}
+	flatten("", value, flat)
+	return flat, nil
+}
+
+func flatten(prefix string, value interface{}, flat map[string]interface{}) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		if len(typed) == 0 && prefix != "" {
+			flat[prefix] = typed
+		}
+		for key, item := range typed {
+			flatten(joinKey(prefix, key), item, flat)
+		}
+	case []interface{}:
+		if len(typed) == 0 && prefix != "" {
+			flat[prefix] = typed
+		}
+		for i, item := range typed {
+			flatten(joinKey(prefix, strconv.Itoa(i)), item, flat)
+		}
+	default:
+		flat[prefix] = typed
+	}
+}
+
+func joinKey(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,41 @@
+package output
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Flatten", func() {
+	It("Uses dotted keys for nested objects and arrays", func() {
+		flat, err := Flatten(map[string]interface{}{
+			"id": "123",
+			"aws": map[string]interface{}{
+				"sts": map[string]interface{}{
+					"role_arn": "arn:aws:iam::123456789012:role/Installer",
+				},
+				"subnet_ids": []string{"subnet-1", "subnet-2"},
+				"tags":       map[string]string{},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flat).To(Equal(map[string]interface{}{
+			"id":               "123",
+			"aws.sts.role_arn": "arn:aws:iam::123456789012:role/Installer",
+			"aws.subnet_ids.0": "subnet-1",
+			"aws.subnet_ids.1": "subnet-2",
+			"aws.tags":         map[string]interface{}{},
+		}))
+	})
+
+	It("Flattens each element of a list", func() {
+		flat, err := Flatten([]map[string]interface{}{
+			{"region": map[string]interface{}{"id": "us-east-1"}},
+			{"region": map[string]interface{}{"id": "eu-west-1"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flat).To(Equal([]map[string]interface{}{
+			{"region.id": "us-east-1"},
+			{"region.id": "eu-west-1"},
+		}))
+	})
+})
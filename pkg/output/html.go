@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to render the output of commands as an HTML report.
+
+package output
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+//go:embed templates/report.html
+var reportTemplateText string
+
+var reportTemplate = template.Must(template.New("report").Parse(reportTemplateText))
+
+// reportKeyRE matches the 'Key: value' lines of a description. The colon has to be followed by
+// a blank so that values such as URLs aren't split.
+var reportKeyRE = regexp.MustCompile(`^([^:]+):(?:\s+(.*))?$`)
+
+type reportRow struct {
+	Level int
+	Key   string
+	Value string
+}
+
+type report struct {
+	Title string
+	Rows  []reportRow
+	Body  string
+}
+
+// PrintHTML prints the human readable description of a resource as an HTML report. Each
+// 'Key: value' line of the description becomes a row of the report, and indented lines are
+// nested under the preceding ones.
+func PrintHTML(title string, description string) error {
+	str, err := renderHTML(report{
+		Title: title,
+		Rows:  reportRows(description),
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Print(str)
+	return nil
+}
+
+func renderHTML(r report) (string, error) {
+	var b bytes.Buffer
+	err := reportTemplate.Execute(&b, r)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func reportRows(description string) []reportRow {
+	rows := []reportRow{}
+	for _, line := range strings.Split(description, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		trimmed := strings.TrimLeft(line, " \t")
+		level := len(line) - len(trimmed)
+		trimmed = strings.TrimPrefix(trimmed, "- ")
+		if trimmed != line && level == 0 {
+			level = 1
+		}
+		row := reportRow{
+			Level: level,
+			Value: strings.TrimSpace(trimmed),
+		}
+		if match := reportKeyRE.FindStringSubmatch(trimmed); match != nil {
+			row.Key = strings.TrimSpace(match[1])
+			row.Value = strings.TrimSpace(match[2])
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,34 @@
+package output
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTML report", func() {
+	It("Splits the description into nested rows", func() {
+		rows := reportRows("Name:    mycluster\n" +
+			"API URL: https://api.example.com:6443\n" +
+			"Nodes:\n" +
+			" - Compute:  2\n" +
+			"   - https://example.com\n")
+		Expect(rows).To(Equal([]reportRow{
+			{Level: 0, Key: "Name", Value: "mycluster"},
+			{Level: 0, Key: "API URL", Value: "https://api.example.com:6443"},
+			{Level: 0, Key: "Nodes", Value: ""},
+			{Level: 1, Key: "Compute", Value: "2"},
+			{Level: 3, Key: "", Value: "https://example.com"},
+		}))
+	})
+
+	It("Escapes the values of the report", func() {
+		str, err := renderHTML(report{
+			Title: "mycluster",
+			Rows:  []reportRow{{Key: "Description", Value: "<script>"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(str).To(ContainSubstring("<title>mycluster</title>"))
+		Expect(str).To(ContainSubstring("&lt;script&gt;"))
+		Expect(str).NotTo(ContainSubstring("<pre>"))
+	})
+})
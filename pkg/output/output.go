@@ -214,14 +214,41 @@ func parseResource(body bytes.Buffer) (string, error) {
 	switch o {
 	case "json":
 		var out bytes.Buffer
+		if !pretty {
+			err := compactJSON(&out, body.Bytes())
+			if err != nil {
+				return "", err
+			}
+			return out.String(), nil
+		}
 		prettifyJSON(&out, body.Bytes())
 		return out.String(), nil
+	case "jsonl":
+		var out bytes.Buffer
+		err := jsonLines(&out, body.Bytes())
+		if err != nil {
+			return "", err
+		}
+		return out.String(), nil
 	case "yaml":
+		// JSON is a subset of YAML, so the compact JSON is the flow style YAML document:
+		if !pretty {
+			var out bytes.Buffer
+			err := compactJSON(&out, body.Bytes())
+			if err != nil {
+				return "", err
+			}
+			return out.String(), nil
+		}
 		out, err := yaml.JSONToYAML(body.Bytes())
 		if err != nil {
 			return "", err
 		}
 		return string(out), nil
+	case "html":
+		var out bytes.Buffer
+		prettifyJSON(&out, body.Bytes())
+		return renderHTML(report{Title: "ROSA", Body: out.String()})
 	default:
 		return "", fmt.Errorf("Unknown format '%s'. Valid formats are %s", o, formats)
 	}
@@ -239,6 +266,35 @@ func prettifyJSON(stream io.Writer, body []byte) error {
 	return dumpJSON(stream, data)
 }
 
+func compactJSON(stream io.Writer, body []byte) error {
+	if len(body) == 0 {
+		return nil
+	}
+	var out bytes.Buffer
+	err := json.Compact(&out, body)
+	if err != nil {
+		return dumpBytes(stream, body)
+	}
+	return dumpBytes(stream, out.Bytes())
+}
+
+// jsonLines writes each element of a list in a line, as compact JSON, so that the output can be
+// consumed by line oriented tools. Other resources are written in a single line.
+func jsonLines(stream io.Writer, body []byte) error {
+	var items []json.RawMessage
+	err := json.Unmarshal(body, &items)
+	if err != nil {
+		return compactJSON(stream, body)
+	}
+	for _, item := range items {
+		err = compactJSON(stream, item)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func dumpBytes(stream io.Writer, data []byte) error {
 	_, err := stream.Write(data)
 	if err != nil {
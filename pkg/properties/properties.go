@@ -24,6 +24,9 @@ const prefix = "rosa_"
 
 const CLIVersion = prefix + "cli_version"
 
+// CreationMode is the mode, 'auto' or 'manual', used to create the roles of STS clusters
+const CreationMode = prefix + "creation_mode"
+
 const FakeCluster = "fake_cluster"
 
 // nolint:gosec // Linter thinks there are hardcoded credentials here...
@@ -171,6 +171,21 @@ func FormatNodePoolList(nodePools []*v1.NodePool) string {
 	}`, len(nodePools), len(nodePools), json.String())
 }
 
+func FormatMachinePoolList(machinePools []*v1.MachinePool) string {
+	var json bytes.Buffer
+
+	v1.MarshalMachinePoolList(machinePools, &json)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "MachinePoolList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(machinePools), len(machinePools), json.String())
+}
+
 func FormatKubeletConfigList(configs []*v1.KubeletConfig) string {
 	var json bytes.Buffer
 
@@ -291,6 +306,21 @@ func FormatNodePoolUpgradePolicyList(upgrades []*v1.NodePoolUpgradePolicy) strin
 	}`, len(upgrades), len(upgrades), outputJson.String())
 }
 
+func FormatUpgradePolicyList(upgrades []*v1.UpgradePolicy) string {
+	var outputJson bytes.Buffer
+
+	v1.MarshalUpgradePolicyList(upgrades, &outputJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "UpgradePolicyList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(upgrades), len(upgrades), outputJson.String())
+}
+
 // FormatResource wraps the SDK marshalling and returns a string starting from an object
 func FormatResource(resource interface{}) string {
 	var outputJson bytes.Buffer
@@ -320,6 +350,10 @@ func FormatResource(resource interface{}) string {
 		if res, ok := resource.(*v1.ControlPlaneUpgradePolicy); ok {
 			err = v1.MarshalControlPlaneUpgradePolicy(res, &outputJson)
 		}
+	case "*v1.UpgradePolicyState":
+		if res, ok := resource.(*v1.UpgradePolicyState); ok {
+			err = v1.MarshalUpgradePolicyState(res, &outputJson)
+		}
 	case "*v1.ExternalAuth":
 		if res, ok := resource.(*v1.ExternalAuth); ok {
 			err = v1.MarshalExternalAuth(res, &outputJson)